@@ -30,7 +30,7 @@ type IndexViewAggFuncTry func(ix *etable.IndexView, colIndex int) ([]float64, er
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func CountIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, 0, CountFunc)
+	return aggCol(ix, colIndex, 0, CountFunc)
 }
 
 // Count returns the count of non-Null, non-NaN elements in given
@@ -67,7 +67,7 @@ func CountTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func SumIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, 0, SumFunc)
+	return aggCol(ix, colIndex, 0, SumFunc)
 }
 
 // Sum returns the sum of non-Null, non-NaN elements in given
@@ -104,7 +104,7 @@ func SumTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func ProdIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, 1, ProdFunc)
+	return aggCol(ix, colIndex, 1, ProdFunc)
 }
 
 // Prod returns the product of non-Null, non-NaN elements in given
@@ -141,7 +141,7 @@ func ProdTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func MaxIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, -math.MaxFloat64, MaxFunc)
+	return aggCol(ix, colIndex, -math.MaxFloat64, MaxFunc)
 }
 
 // Max returns the maximum of non-Null, non-NaN elements in given
@@ -178,7 +178,7 @@ func MaxTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func MinIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, math.MaxFloat64, MinFunc)
+	return aggCol(ix, colIndex, math.MaxFloat64, MinFunc)
 }
 
 // Min returns the minimum of non-Null, non-NaN elements in given
@@ -207,6 +207,87 @@ func MinTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 	return MinIndex(ix, colIndex), nil
 }
 
+///////////////////////////////////////////////////
+//   MaxStrict, MinStrict
+
+// MaxStrictIndex returns the maximum of elements in given IndexView
+// indexed view of an etable.Table, for given column index, where any
+// missing (Null or NaN) value makes the maximum for that cell NaN,
+// regardless of SkipMissing, to indicate the presence of missing data
+// (like numpy max, vs. nanmax for MaxIndex).
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MaxStrictIndex(ix *etable.IndexView, colIndex int) []float64 {
+	return missingToNaN(ix, colIndex, ix.AggCol(colIndex, -math.MaxFloat64, MaxFunc))
+}
+
+// MaxStrict returns the maximum of elements in given IndexView
+// indexed view of an etable.Table, for given column name, where any
+// missing (Null or NaN) value makes the maximum for that cell NaN.
+// If name not found, nil is returned -- use Try version for error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MaxStrict(ix *etable.IndexView, colNm string) []float64 {
+	colIndex := ix.Table.ColIndex(colNm)
+	if colIndex == -1 {
+		return nil
+	}
+	return MaxStrictIndex(ix, colIndex)
+}
+
+// MaxStrictTry returns the maximum of elements in given IndexView
+// indexed view of an etable.Table, for given column name, where any
+// missing (Null or NaN) value makes the maximum for that cell NaN.
+// If name not found, returns error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MaxStrictTry(ix *etable.IndexView, colNm string) ([]float64, error) {
+	colIndex, err := ix.Table.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return MaxStrictIndex(ix, colIndex), nil
+}
+
+// MinStrictIndex returns the minimum of elements in given IndexView
+// indexed view of an etable.Table, for given column index, where any
+// missing (Null or NaN) value makes the minimum for that cell NaN,
+// regardless of SkipMissing, to indicate the presence of missing data
+// (like numpy min, vs. nanmin for MinIndex).
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MinStrictIndex(ix *etable.IndexView, colIndex int) []float64 {
+	return missingToNaN(ix, colIndex, ix.AggCol(colIndex, math.MaxFloat64, MinFunc))
+}
+
+// MinStrict returns the minimum of elements in given IndexView
+// indexed view of an etable.Table, for given column name, where any
+// missing (Null or NaN) value makes the minimum for that cell NaN.
+// If name not found, nil is returned -- use Try version for error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MinStrict(ix *etable.IndexView, colNm string) []float64 {
+	colIndex := ix.Table.ColIndex(colNm)
+	if colIndex == -1 {
+		return nil
+	}
+	return MinStrictIndex(ix, colIndex)
+}
+
+// MinStrictTry returns the minimum of elements in given IndexView
+// indexed view of an etable.Table, for given column name, where any
+// missing (Null or NaN) value makes the minimum for that cell NaN.
+// If name not found, returns error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MinStrictTry(ix *etable.IndexView, colNm string) ([]float64, error) {
+	colIndex, err := ix.Table.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return MinStrictIndex(ix, colIndex), nil
+}
+
 ///////////////////////////////////////////////////
 //   Mean
 
@@ -275,7 +356,7 @@ func VarIndex(ix *etable.IndexView, colIndex int) []float64 {
 	}
 	col := ix.Table.Cols[colIndex]
 	_, csz := col.RowCellSize()
-	vr := ix.AggCol(colIndex, 0, func(idx int, val float64, agg float64) float64 {
+	vr := aggCol(ix, colIndex, 0, func(idx int, val float64, agg float64) float64 {
 		cidx := idx % csz
 		dv := val - mean[cidx]
 		return agg + dv*dv
@@ -431,7 +512,7 @@ func VarPopIndex(ix *etable.IndexView, colIndex int) []float64 {
 	}
 	col := ix.Table.Cols[colIndex]
 	_, csz := col.RowCellSize()
-	vr := ix.AggCol(colIndex, 0, func(idx int, val float64, agg float64) float64 {
+	vr := aggCol(ix, colIndex, 0, func(idx int, val float64, agg float64) float64 {
 		cidx := idx % csz
 		dv := val - mean[cidx]
 		return agg + dv*dv
@@ -574,7 +655,7 @@ func SemPopTry(ix *etable.IndexView, colNm string) ([]float64, error) {
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func SumSqIndex(ix *etable.IndexView, colIndex int) []float64 {
-	return ix.AggCol(colIndex, 0, SumSqFunc)
+	return aggCol(ix, colIndex, 0, SumSqFunc)
 }
 
 // SumSq returns the sum-of-squares of non-Null, non-NaN elements in given
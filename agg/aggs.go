@@ -152,3 +152,38 @@ func AggTry(ix *etable.IndexView, colNm string, ag Aggs) ([]float64, error) {
 	}
 	return rv, nil
 }
+
+// CachedIndex returns aggregate according to given agg type applied
+// to all elements in given IndexView indexed view of an etable.Table,
+// for given column index, with missing values handled according to
+// SkipMissing as in AggIndex, using the IndexView.CachedAgg
+// cache to avoid recomputing it if the view and table have not changed,
+// e.g., for repeated GUI updates.  Values are cached separately for each
+// SkipMissing setting.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func CachedIndex(ix *etable.IndexView, colIndex int, ag Aggs) []float64 {
+	name := ag.String()
+	if !SkipMissing {
+		name += ":KeepMissing"
+	}
+	return ix.CachedAgg(colIndex, name, func(ix *etable.IndexView, colIndex int) []float64 {
+		return AggIndex(ix, colIndex, ag)
+	})
+}
+
+// Cached returns aggregate according to given agg type applied
+// to all elements in given IndexView indexed view of an etable.Table,
+// for given column name, with missing values handled according to
+// SkipMissing, using the IndexView.CachedAgg cache to avoid recomputing
+// it if the view and table have not changed -- see CachedIndex.
+// If name not found, nil is returned.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func Cached(ix *etable.IndexView, colNm string, ag Aggs) []float64 {
+	colIndex := ix.Table.ColIndex(colNm)
+	if colIndex == -1 {
+		return nil
+	}
+	return CachedIndex(ix, colIndex, ag)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestCached(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for i := 0; i < 4; i++ {
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	ix := etable.NewIndexView(dt)
+	ncomp := 0
+	mean := func(ix *etable.IndexView, colIndex int) []float64 {
+		ncomp++
+		return MeanIndex(ix, colIndex)
+	}
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != 1.5 {
+		t.Errorf("CachedAgg Mean: %v != 1.5", m[0])
+	}
+	ix.CachedAgg(0, "Mean", mean)
+	if ncomp != 1 {
+		t.Errorf("CachedAgg: recomputed unchanged view: %d", ncomp)
+	}
+	ix.Head(2)
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != .5 || ncomp != 2 {
+		t.Errorf("CachedAgg after Indexes change: %v != .5, computes %d", m[0], ncomp)
+	}
+	dt.SetCellFloat("Val", 0, 3)
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != 2 || ncomp != 3 {
+		t.Errorf("CachedAgg after Table change: %v != 2, computes %d", m[0], ncomp)
+	}
+	if mx := Cached(ix, "Val", AggMax); mx[0] != 3 {
+		t.Errorf("Cached Max: %v != 3", mx[0])
+	}
+	if Cached(ix, "NoCol", AggMax) != nil {
+		t.Error("Cached: expected nil for missing column")
+	}
+	dt.ColByName("Val").SetNull1D(1, true)
+	dt.Changed()
+	if m := Cached(ix, "Val", AggMean); math.IsNaN(m[0]) {
+		t.Errorf("Cached Mean skipping missing: %v", m[0])
+	}
+	SkipMissing = false
+	if m := Cached(ix, "Val", AggMean); !math.IsNaN(m[0]) {
+		t.Errorf("Cached Mean with SkipMissing false: %v != NaN", m[0])
+	}
+	SkipMissing = true
+	dt.ColByName("Val").SetNull1D(1, false)
+	dt.Changed()
+	ix.Indexes = ix.Indexes[:1] // direct change
+	ix.IndexesChanged()
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != 3 || ncomp != 4 {
+		t.Errorf("CachedAgg after IndexesChanged: %v != 3, computes %d", m[0], ncomp)
+	}
+	dt.ColByName("Val").SetFloat1D(0, 5) // direct write
+	dt.Changed()
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != 5 || ncomp != 5 {
+		t.Errorf("CachedAgg after direct write and Changed: %v != 5, computes %d", m[0], ncomp)
+	}
+
+	// adding and deleting columns must not return the values of another column
+	dt.AddCol(etensor.NewFloat64([]int{4}, nil, nil), "Other")
+	if m := ix.CachedAgg(1, "Mean", mean); m[0] != 0 {
+		t.Errorf("CachedAgg after AddCol: %v != 0", m[0])
+	}
+	dt.DeleteColIndex(0)
+	if m := ix.CachedAgg(0, "Mean", mean); m[0] != 0 {
+		t.Errorf("CachedAgg after DeleteColIndex: %v != 0", m[0])
+	}
+}
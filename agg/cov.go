@@ -0,0 +1,81 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+// CovMatrix returns a symmetric 2D tensor of the pairwise sample covariances
+// (normalized by 1/(n-1), as in Var) of the given 1D columns across the rows
+// of given IndexView, with one row and column per column name in order, so
+// the diagonal has the variance of each column.  Each pair of columns uses
+// the rows where neither has a missing (Null or NaN) value, or is NaN if
+// SkipMissing is false and there are any missing values in the pair.
+// Returns an error if a column is not found or is not 1D.
+func CovMatrix(ix *etable.IndexView, colNames []string) (etensor.Tensor, error) {
+	nc := len(colNames)
+	cols := make([]etensor.Tensor, nc)
+	for i, cn := range colNames {
+		col, err := ix.Table.ColByNameTry(cn)
+		if err != nil {
+			return nil, fmt.Errorf("agg.CovMatrix: %w", err)
+		}
+		if col.NumDims() != 1 {
+			return nil, fmt.Errorf("agg.CovMatrix: column %q is not 1D", cn)
+		}
+		cols[i] = col
+	}
+	cmat := etensor.NewFloat64([]int{nc, nc}, nil, nil)
+	for i := 0; i < nc; i++ {
+		for j := i; j < nc; j++ {
+			cv := pairCov(ix, cols[i], cols[j])
+			cmat.Set([]int{i, j}, cv)
+			cmat.Set([]int{j, i}, cv)
+		}
+	}
+	return cmat, nil
+}
+
+// pairCov returns the sample covariance of the two given 1D columns across
+// the rows of given IndexView where neither value is missing.
+func pairCov(ix *etable.IndexView, a, b etensor.Tensor) float64 {
+	missing := func(col etensor.Tensor, row int) bool {
+		return col.IsNull1D(row) || math.IsNaN(col.FloatValue1D(row))
+	}
+	var rows []int
+	for _, row := range ix.Indexes {
+		if missing(a, row) || missing(b, row) {
+			if !SkipMissing {
+				return math.NaN()
+			}
+			continue
+		}
+		rows = append(rows, row)
+	}
+	n := float64(len(rows))
+	if n == 0 {
+		return 0
+	}
+	am, bm := 0.0, 0.0
+	for _, row := range rows {
+		am += a.FloatValue1D(row)
+		bm += b.FloatValue1D(row)
+	}
+	am /= n
+	bm /= n
+	cv := 0.0
+	for _, row := range rows {
+		cv += (a.FloatValue1D(row) - am) * (b.FloatValue1D(row) - bm)
+	}
+	if n > 1 {
+		cv /= n - 1
+	}
+	return cv
+}
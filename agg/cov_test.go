@@ -0,0 +1,66 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestCovMatrix(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"A", etensor.FLOAT64, nil, nil},
+		{"B", etensor.FLOAT32, nil, nil},
+		{"C", etensor.INT64, nil, nil},
+	}, 6)
+	a := []float64{1, 2, 3, 4, 5, 6}
+	b := []float64{2, 1, 4, 3, 6, math.NaN()}
+	c := []float64{6, 5, 4, 3, 2, 1}
+	for i := range a {
+		dt.SetCellFloat("A", i, a[i])
+		dt.SetCellFloat("B", i, b[i])
+		dt.SetCellFloat("C", i, c[i])
+	}
+	ix := etable.NewIndexView(dt)
+	names := []string{"A", "B", "C"}
+	cm, err := CovMatrix(ix, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cm.Dim(0) != 3 || cm.Dim(1) != 3 {
+		t.Fatalf("CovMatrix shape: %v", cm.Shapes())
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if cm.FloatValue([]int{i, j}) != cm.FloatValue([]int{j, i}) {
+				t.Errorf("CovMatrix not symmetric at %d, %d", i, j)
+			}
+		}
+		if vr := Var(ix, names[i])[0]; math.Abs(cm.FloatValue([]int{i, i})-vr) > 1.0e-6 {
+			t.Errorf("CovMatrix diagonal %s: %g != Var: %g", names[i], cm.FloatValue([]int{i, i}), vr)
+		}
+	}
+	if v := cm.FloatValue([]int{0, 2}); math.Abs(v+3.5) > 1.0e-9 {
+		t.Errorf("CovMatrix A, C: %g != -3.5", v)
+	}
+	// A, B pairwise over first 5 rows: means 3, 3.2
+	if v := cm.FloatValue([]int{0, 1}); math.Abs(v-2.5) > 1.0e-6 {
+		t.Errorf("CovMatrix A, B pairwise: %g != 2.5", v)
+	}
+
+	SkipMissing = false
+	cm, _ = CovMatrix(ix, names)
+	SkipMissing = true
+	if !math.IsNaN(cm.FloatValue([]int{0, 1})) || math.IsNaN(cm.FloatValue([]int{0, 2})) {
+		t.Errorf("CovMatrix propagate missing: %g %g", cm.FloatValue([]int{0, 1}), cm.FloatValue([]int{0, 2}))
+	}
+
+	if _, err := CovMatrix(ix, []string{"A", "X"}); err == nil {
+		t.Error("CovMatrix: expected error for missing column")
+	}
+}
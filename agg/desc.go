@@ -5,6 +5,8 @@
 package agg
 
 import (
+	"math"
+
 	"github.com/emer/etable/v2/etable"
 	"github.com/emer/etable/v2/etensor"
 )
@@ -67,6 +69,93 @@ func DescAll(ix *etable.IndexView) *etable.Table {
 	return dt
 }
 
+// DescribeColsAggs are the names of the statistics computed by DescribeCols,
+// which are the names of its columns after the first Col column.
+var DescribeColsAggs = []string{"Count", "Mean", "Std", "Min", "Q1", "Median", "Q3", "Max"}
+
+// DescribeCols returns a table with a row for each numeric column with
+// scalar (1D) cells in given table, with the column name in the first Col
+// column, and the standard descriptive statistics listed in DescribeColsAggs
+// in the remaining columns, operating over all non-Null, non-NaN elements
+// in each column.  This is the analog of the pandas describe() function,
+// transposed relative to DescAll, and computes all the statistics for each
+// column in one pass over its sorted values.  Std is the sample standard
+// deviation, and the quantiles use linear interpolation as in QuantilesIndex.
+// Columns with no values have a Count of 0 and NaN for the other stats,
+// and if SkipMissing is false, columns with missing values have all NaN stats.
+func DescribeCols(ix *etable.IndexView) *etable.Table {
+	st := ix.Table
+	var cis []int
+	for ci, col := range st.Cols {
+		if col.DataType() == etensor.STRING || col.NumDims() != 1 {
+			continue
+		}
+		cis = append(cis, ci)
+	}
+	sc := etable.Schema{
+		{"Col", etensor.STRING, nil, nil},
+	}
+	for _, an := range DescribeColsAggs {
+		sc = append(sc, etable.Column{an, etensor.FLOAT64, nil, nil})
+	}
+	dt := etable.New(sc, len(cis))
+	for ri, ci := range cis {
+		dt.Cols[0].SetString1D(ri, st.ColNames[ci])
+		for ai, v := range describeValues(ix, ci) {
+			dt.Cols[1+ai].SetFloat1D(ri, v)
+		}
+	}
+	return dt
+}
+
+// describeValues returns the DescribeColsAggs statistics for given 1D column.
+func describeValues(ix *etable.IndexView, colIndex int) []float64 {
+	rvs := make([]float64, len(DescribeColsAggs))
+	vals := SortedCellValues(ix, colIndex, 0)
+	n := len(vals)
+	if n == 0 || (!SkipMissing && MissingCells(ix, colIndex)[0]) {
+		for i := range rvs {
+			rvs[i] = math.NaN()
+		}
+		if n == 0 {
+			rvs[0] = 0
+		}
+		return rvs
+	}
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+	mean := sum / float64(n)
+	std := 0.0
+	if n > 1 {
+		ss := 0.0
+		for _, v := range vals {
+			d := v - mean
+			ss += d * d
+		}
+		std = math.Sqrt(ss / float64(n-1))
+	}
+	quant := func(q float64) float64 {
+		qi := q * float64(n-1)
+		lwi := int(math.Floor(qi))
+		if lwi >= n-1 {
+			return vals[n-1]
+		}
+		phi := qi - float64(lwi)
+		return (1-phi)*vals[lwi] + phi*vals[lwi+1]
+	}
+	rvs[0] = float64(n)
+	rvs[1] = mean
+	rvs[2] = std
+	rvs[3] = vals[0]
+	rvs[4] = quant(.25)
+	rvs[5] = quant(.5)
+	rvs[6] = quant(.75)
+	rvs[7] = vals[n-1]
+	return rvs
+}
+
 // DescIndex returns a table of standard descriptive aggregates
 // of non-Null, non-NaN elements in given IndexView indexed view of an
 // etable.Table, for given column index.
@@ -0,0 +1,53 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestDescribeCols(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"RT", etensor.FLOAT64, nil, nil},
+		{"Trial", etensor.INT, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 9)
+	for i := 0; i < dt.Rows; i++ {
+		dt.SetCellFloat("RT", i, float64(i*i))
+		dt.SetCellFloat("Trial", i, float64(i))
+	}
+	ix := etable.NewIndexView(dt)
+	ds := DescribeCols(ix)
+	if ds.Rows != 2 || ds.NumCols() != 1+len(DescribeColsAggs) {
+		t.Fatalf("DescribeCols: rows: %d cols: %v", ds.Rows, ds.ColNames)
+	}
+	if ds.CellString("Col", 0) != "RT" || ds.CellString("Col", 1) != "Trial" {
+		t.Errorf("DescribeCols: Col: %v %v", ds.CellString("Col", 0), ds.CellString("Col", 1))
+	}
+	errtol := 1.0e-9
+	exp := map[string]float64{
+		"Count":  Count(ix, "RT")[0],
+		"Mean":   Mean(ix, "RT")[0],
+		"Std":    Std(ix, "RT")[0],
+		"Min":    Min(ix, "RT")[0],
+		"Q1":     Q1(ix, "RT")[0],
+		"Median": Median(ix, "RT")[0],
+		"Q3":     Q3(ix, "RT")[0],
+		"Max":    Max(ix, "RT")[0],
+	}
+	for _, an := range DescribeColsAggs {
+		if v := ds.CellFloat(an, 0); math.Abs(v-exp[an]) > errtol {
+			t.Errorf("DescribeCols RT %s: %v != %v", an, v, exp[an])
+		}
+	}
+	if v := ds.CellFloat("Median", 1); v != 4 {
+		t.Errorf("DescribeCols Trial Median: %v != 4", v)
+	}
+}
@@ -10,6 +10,11 @@ at any level of aggregation from etensor on up.
 The main functions use names to specify columns, and *Index and *Try versions
 are available that operate on column indexes and return errors, respectively.
 
+By default, missing data (Null or NaN values) are skipped -- set
+SkipMissing to false to instead have any missing value in a cell make
+the aggregate for that cell NaN.  MaxStrict and MinStrict always
+propagate missing values in this way, regardless of SkipMissing.
+
 See tsragg package for functions that operate directly on a etensor.Tensor
 without the indexview indirection.
 */
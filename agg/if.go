@@ -18,7 +18,7 @@ type IfFunc func(idx int, val float64) bool
 // Return value is size of each column cell -- 1 for scalar 1D columns
 // and N for higher-dimensional columns.
 func CountIfIndex(ix *etable.IndexView, colIndex int, iffun IfFunc) []float64 {
-	return ix.AggCol(colIndex, 0, func(idx int, val float64, agg float64) float64 {
+	return aggCol(ix, colIndex, 0, func(idx int, val float64, agg float64) float64 {
 		if iffun(idx, val) {
 			return agg + 1
 		}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+// SkipMissing determines how the aggregation functions in this package
+// treat missing data (Null or NaN values).  If true (the default),
+// missing values are skipped and the aggregate is computed over the
+// remaining values.  If false, missing values propagate: any missing
+// value in a given cell across the rows of the IndexView makes the
+// aggregate for that cell NaN, for all aggregates including Count.
+var SkipMissing = true
+
+// MissingCells returns, for each cell of given column, whether any of the
+// rows in given IndexView has a missing (Null or NaN) value in that cell.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func MissingCells(ix *etable.IndexView, colIndex int) []bool {
+	col := ix.Table.Cols[colIndex]
+	_, csz := col.RowCellSize()
+	ms := make([]bool, csz)
+	for _, row := range ix.Indexes {
+		si := row * csz
+		for j := range ms {
+			if col.IsNull1D(si+j) || math.IsNaN(col.FloatValue1D(si+j)) {
+				ms[j] = true
+			}
+		}
+	}
+	return ms
+}
+
+// propagateMissing sets the aggregate values in rvs to NaN for all cells
+// that have missing values, if SkipMissing is false.  Returns rvs.
+func propagateMissing(ix *etable.IndexView, colIndex int, rvs []float64) []float64 {
+	if SkipMissing {
+		return rvs
+	}
+	return missingToNaN(ix, colIndex, rvs)
+}
+
+// missingToNaN sets the aggregate values in rvs to NaN for all cells
+// that have missing values, regardless of SkipMissing.  Returns rvs.
+func missingToNaN(ix *etable.IndexView, colIndex int, rvs []float64) []float64 {
+	if rvs == nil {
+		return rvs
+	}
+	ms := MissingCells(ix, colIndex)
+	for i := range rvs {
+		if i < len(ms) && ms[i] {
+			rvs[i] = math.NaN()
+		}
+	}
+	return rvs
+}
+
+// aggCol calls AggCol on given IndexView, and applies the SkipMissing
+// policy to the result.  All aggregates in this package use this.
+func aggCol(ix *etable.IndexView, colIndex int, ini float64, fun etensor.AggFunc) []float64 {
+	return propagateMissing(ix, colIndex, ix.AggCol(colIndex, ini, fun))
+}
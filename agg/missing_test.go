@@ -0,0 +1,86 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestSkipMissing(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT64, []int{2}, nil},
+	}, 4)
+	for i := 0; i < 4; i++ {
+		dt.SetCellFloat("Val", i, float64(i+1))
+		dt.SetCellTensorFloat1D("Vec", i, 0, float64(i))
+		dt.SetCellTensorFloat1D("Vec", i, 1, 1)
+	}
+	dt.SetCellFloat("Val", 2, math.NaN())
+	dt.SetCellTensorFloat1D("Vec", 1, 0, math.NaN())
+	ix := etable.NewIndexView(dt)
+
+	if cnt := Count(ix, "Val")[0]; cnt != 3 {
+		t.Errorf("SkipMissing Count: %v != 3", cnt)
+	}
+	if mn := Mean(ix, "Val")[0]; math.Abs(mn-7.0/3.0) > 1.0e-9 {
+		t.Errorf("SkipMissing Mean: %v", mn)
+	}
+
+	SkipMissing = false
+	defer func() { SkipMissing = true }()
+	for _, ag := range []Aggs{AggCount, AggSum, AggMean, AggVar, AggStd, AggMin, AggMax, AggMedian} {
+		if v := AggIndex(ix, 0, ag)[0]; !math.IsNaN(v) {
+			t.Errorf("propagate %s: %v should be NaN", AggsName(ag), v)
+		}
+	}
+	vm := Mean(ix, "Vec")
+	if !math.IsNaN(vm[0]) || vm[1] != 1 {
+		t.Errorf("propagate Mean Vec: %v should be [NaN 1]", vm)
+	}
+}
+
+func TestMinMaxStrict(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT64, []int{2}, nil},
+	}, 3)
+	for i := 0; i < 3; i++ {
+		dt.SetCellFloat("Val", i, float64(i+1))
+		dt.SetCellTensorFloat1D("Vec", i, 0, float64(i))
+		dt.SetCellTensorFloat1D("Vec", i, 1, float64(-i))
+	}
+	dt.SetCellFloat("Val", 1, math.NaN())
+	dt.SetCellTensorFloat1D("Vec", 2, 1, math.NaN())
+	ix := etable.NewIndexView(dt)
+
+	if mx := Max(ix, "Val")[0]; mx != 3 {
+		t.Errorf("Max skip: %v != 3", mx)
+	}
+	if mn := Min(ix, "Val")[0]; mn != 1 {
+		t.Errorf("Min skip: %v != 1", mn)
+	}
+	if mx := MaxStrict(ix, "Val")[0]; !math.IsNaN(mx) {
+		t.Errorf("MaxStrict: %v should be NaN", mx)
+	}
+	if mn, _ := MinStrictTry(ix, "Val"); !math.IsNaN(mn[0]) {
+		t.Errorf("MinStrict: %v should be NaN", mn)
+	}
+	vm := MaxStrict(ix, "Vec")
+	if vm[0] != 2 || !math.IsNaN(vm[1]) {
+		t.Errorf("MaxStrict Vec: %v should be [2 NaN]", vm)
+	}
+	vm = MinStrictIndex(ix, 1)
+	if vm[0] != 0 || !math.IsNaN(vm[1]) {
+		t.Errorf("MinStrict Vec: %v should be [0 NaN]", vm)
+	}
+	if _, err := MaxStrictTry(ix, "Nope"); err == nil {
+		t.Error("MaxStrictTry: expected error for missing column")
+	}
+}
@@ -7,6 +7,7 @@ package agg
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/emer/etable/v2/etable"
 )
@@ -16,7 +17,8 @@ import (
 // Column must be a 1d Column -- returns nil for n-dimensional columns.
 // qs are 0-1 values, 0 = min, 1 = max, .5 = median, etc.  Uses linear interpolation.
 // Because this requires a sort, it is more efficient to get as many quantiles
-// as needed in one pass.
+// as needed in one pass.  The values are sorted by SortedCellValues,
+// and NaN is returned if there are no values.
 func QuantilesIndex(ix *etable.IndexView, colIndex int, qs []float64) []float64 {
 	nq := len(qs)
 	if nq == 0 {
@@ -27,30 +29,30 @@ func QuantilesIndex(ix *etable.IndexView, colIndex int, qs []float64) []float64
 		return nil
 	}
 	rvs := make([]float64, nq)
-	six := ix.Clone()                                 // leave original indexes intact
-	six.Filter(func(et *etable.Table, row int) bool { // get rid of nulls in this column
-		if col.IsNull1D(row) {
-			return false
+	if !SkipMissing && MissingCells(ix, colIndex)[0] {
+		for i := range rvs {
+			rvs[i] = math.NaN()
 		}
-		return true
-	})
-	six.SortCol(colIndex, true)
-	sz := len(six.Indexes) - 1 // length of our own index list
+		return rvs
+	}
+	vals := SortedCellValues(ix, colIndex, 0)
+	sz := len(vals) - 1
 	fsz := float64(sz)
 	for i, q := range qs {
 		val := 0.0
 		qi := q * fsz
 		lwi := math.Floor(qi)
 		lwii := int(lwi)
-		if lwii >= sz {
-			val = col.FloatValue1D(six.Indexes[sz])
-		} else if lwii < 0 {
-			val = col.FloatValue1D(six.Indexes[0])
-		} else {
+		switch {
+		case sz < 0: // no values
+			val = math.NaN()
+		case lwii >= sz:
+			val = vals[sz]
+		case lwii < 0:
+			val = vals[0]
+		default:
 			phi := qi - lwi
-			lwv := col.FloatValue1D(six.Indexes[lwii])
-			hiv := col.FloatValue1D(six.Indexes[lwii+1])
-			val = (1-phi)*lwv + phi*hiv
+			val = (1-phi)*vals[lwii] + phi*vals[lwii+1]
 		}
 		rvs[i] = val
 	}
@@ -74,8 +76,9 @@ func Quantiles(ix *etable.IndexView, colNm string, qs []float64) []float64 {
 
 // QuantilesTry returns the given quantile(s) of non-Null, non-NaN elements in given
 // IndexView indexed view of an etable.Table, for given column name
-// If name not found, error message is returned.
-// Column must be a 1d Column -- returns nil for n-dimensional columns.
+// If name not found, error message is returned, wrapping etable.ErrColNotFound.
+// Column must be a 1d Column -- returns an error wrapping etable.ErrColDims
+// for n-dimensional columns.
 // qs are 0-1 values, 0 = min, 1 = max, .5 = median, etc.  Uses linear interpolation.
 // Because this requires a sort, it is more efficient to get as many quantiles
 // as needed in one pass.
@@ -84,9 +87,97 @@ func QuantilesTry(ix *etable.IndexView, colNm string, qs []float64) ([]float64,
 	if err != nil {
 		return nil, err
 	}
-	rv := QuantilesIndex(ix, colIndex, qs)
-	if rv == nil {
-		return nil, fmt.Errorf("etable agg.QuantilesTry: either qs: %v empty or column: %v not 1D", qs, colNm)
+	if len(qs) == 0 {
+		return nil, fmt.Errorf("etable agg.QuantilesTry: no quantiles given for column: %v", colNm)
+	}
+	if ix.Table.Cols[colIndex].NumDims() > 1 {
+		return nil, fmt.Errorf("etable agg.QuantilesTry: column: %v is not 1D: %w", colNm, etable.ErrColDims)
+	}
+	return QuantilesIndex(ix, colIndex, qs), nil
+}
+
+// SortedCellValues returns the sorted non-Null, non-NaN values of given
+// cell element index (0 for scalar columns) across the rows of given
+// IndexView indexed view of an etable.Table, for given column index.
+func SortedCellValues(ix *etable.IndexView, colIndex int, cellIndex int) []float64 {
+	col := ix.Table.Cols[colIndex]
+	_, csz := col.RowCellSize()
+	vals := make([]float64, 0, len(ix.Indexes))
+	for _, row := range ix.Indexes {
+		i := row*csz + cellIndex
+		if col.IsNull1D(i) {
+			continue
+		}
+		v := col.FloatValue1D(i)
+		if math.IsNaN(v) {
+			continue
+		}
+		vals = append(vals, v)
+	}
+	sort.Float64s(vals)
+	return vals
+}
+
+// TrimmedMeanIndex returns the trimmed mean of non-Null, non-NaN elements in given
+// IndexView indexed view of an etable.Table, for given column index,
+// discarding the lowest and highest trimFrac proportion of values in each cell
+// before averaging (e.g., .1 = drop the bottom and top 10%).
+// trimFrac must be in [0, .5) -- returns nil otherwise.  trimFrac = 0 is the Mean.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.  Cells with no values are NaN.
+func TrimmedMeanIndex(ix *etable.IndexView, colIndex int, trimFrac float64) []float64 {
+	if trimFrac < 0 || trimFrac >= .5 {
+		return nil
+	}
+	_, csz := ix.Table.Cols[colIndex].RowCellSize()
+	rvs := make([]float64, csz)
+	for ci := range rvs {
+		vals := SortedCellValues(ix, colIndex, ci)
+		trim := int(trimFrac * float64(len(vals)))
+		vals = vals[trim : len(vals)-trim]
+		if len(vals) == 0 {
+			rvs[ci] = math.NaN()
+			continue
+		}
+		sum := 0.0
+		for _, v := range vals {
+			sum += v
+		}
+		rvs[ci] = sum / float64(len(vals))
+	}
+	return propagateMissing(ix, colIndex, rvs)
+}
+
+// TrimmedMean returns the trimmed mean of non-Null, non-NaN elements in given
+// IndexView indexed view of an etable.Table, for given column name,
+// discarding the lowest and highest trimFrac proportion of values in each cell
+// before averaging (e.g., .1 = drop the bottom and top 10%).
+// If name not found or trimFrac not in [0, .5), nil is returned -- use Try version
+// for error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func TrimmedMean(ix *etable.IndexView, colNm string, trimFrac float64) []float64 {
+	colIndex := ix.Table.ColIndex(colNm)
+	if colIndex == -1 {
+		return nil
+	}
+	return TrimmedMeanIndex(ix, colIndex, trimFrac)
+}
+
+// TrimmedMeanTry returns the trimmed mean of non-Null, non-NaN elements in given
+// IndexView indexed view of an etable.Table, for given column name,
+// discarding the lowest and highest trimFrac proportion of values in each cell
+// before averaging (e.g., .1 = drop the bottom and top 10%).
+// If name not found or trimFrac not in [0, .5), returns error message.
+// Return value is size of each column cell -- 1 for scalar 1D columns
+// and N for higher-dimensional columns.
+func TrimmedMeanTry(ix *etable.IndexView, colNm string, trimFrac float64) ([]float64, error) {
+	colIndex, err := ix.Table.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	if trimFrac < 0 || trimFrac >= .5 {
+		return nil, fmt.Errorf("etable agg.TrimmedMeanTry: trimFrac: %v must be in [0, .5)", trimFrac)
 	}
-	return rv, nil
+	return TrimmedMeanIndex(ix, colIndex, trimFrac), nil
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestTrimmedMean(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"RT", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT64, []int{2}, nil},
+	}, 10)
+	for i := 0; i < 10; i++ {
+		dt.SetCellFloat("RT", i, float64(i+1))
+		dt.SetCellTensorFloat1D("Vec", i, 0, float64(i))
+		dt.SetCellTensorFloat1D("Vec", i, 1, 2)
+	}
+	dt.SetCellFloat("RT", 3, 1000) // outlier
+	ix := etable.NewIndexView(dt)
+
+	errtol := 1.0e-9
+	mn := Mean(ix, "RT")[0]
+	tm := TrimmedMean(ix, "RT", 0)
+	if math.Abs(tm[0]-mn) > errtol {
+		t.Errorf("TrimmedMean 0: %v != Mean %v", tm[0], mn)
+	}
+	// 10% of 10 values drops the min (1) and the max (1000)
+	tm = TrimmedMean(ix, "RT", .1)
+	exp := float64(2+3+5+6+7+8+9+10) / 8
+	if math.Abs(tm[0]-exp) > errtol {
+		t.Errorf("TrimmedMean .1: %v != %v", tm[0], exp)
+	}
+	tm = TrimmedMean(ix, "Vec", .2)
+	if len(tm) != 2 || math.Abs(tm[0]-4.5) > errtol || tm[1] != 2 {
+		t.Errorf("TrimmedMean Vec .2: %v != [4.5 2]", tm)
+	}
+	if TrimmedMean(ix, "RT", .5) != nil {
+		t.Error("TrimmedMean: expected nil for trimFrac .5")
+	}
+	if _, err := TrimmedMeanTry(ix, "RT", -.1); err == nil {
+		t.Error("TrimmedMeanTry: expected error for negative trimFrac")
+	}
+	if _, err := TrimmedMeanTry(ix, "Nope", .1); err == nil {
+		t.Error("TrimmedMeanTry: expected error for missing column")
+	}
+}
+
+func TestQuantilesNaN(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"RT", etensor.FLOAT64, nil, nil},
+	}, 6)
+	for i, v := range []float64{4, math.NaN(), 1, 3, 100, 2} {
+		dt.SetCellFloat("RT", i, v)
+	}
+	dt.ColByName("RT").SetNull1D(4, true)
+	ix := etable.NewIndexView(dt)
+	qs := Quantiles(ix, "RT", []float64{0, .5, 1})
+	if qs[0] != 1 || qs[1] != 2.5 || qs[2] != 4 {
+		t.Errorf("Quantiles with NaN and Null: %v != [1 2.5 4]", qs)
+	}
+	ix.Indexes = []int{1, 4}
+	if qs := Quantiles(ix, "RT", []float64{.5}); !math.IsNaN(qs[0]) {
+		t.Errorf("Quantiles with no values: %v != NaN", qs[0])
+	}
+}
+
+func TestTryErrors(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT64, []int{3}, nil},
+	}, 4)
+	ix := etable.NewIndexView(dt)
+	if _, err := MeanTry(ix, "Bogus"); !errors.Is(err, etable.ErrColNotFound) {
+		t.Errorf("MeanTry: error: %v is not etable.ErrColNotFound", err)
+	}
+	if _, err := QuantilesTry(ix, "Bogus", []float64{.5}); !errors.Is(err, etable.ErrColNotFound) {
+		t.Errorf("QuantilesTry: error: %v is not etable.ErrColNotFound", err)
+	}
+	if _, err := QuantilesTry(ix, "Vec", []float64{.5}); !errors.Is(err, etable.ErrColDims) {
+		t.Errorf("QuantilesTry: error: %v is not etable.ErrColDims", err)
+	}
+	if _, err := QuantilesTry(ix, "Val", []float64{.5}); err != nil {
+		t.Error(err)
+	}
+}
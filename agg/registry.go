@@ -0,0 +1,74 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/emer/etable/v2/etable"
+)
+
+// registry of named aggregation functions, with lower-case name keys
+var (
+	registryMu    sync.RWMutex
+	registry      = map[string]IndexViewAggFunc{}
+	registryNames = map[string]string{}
+)
+
+func init() {
+	for _, ag := range AggsValues() {
+		ag := ag
+		Register(AggsName(ag), func(ix *etable.IndexView, colNm string) []float64 {
+			return Agg(ix, colNm, ag)
+		})
+	}
+}
+
+// Register adds given aggregation function to the registry under given name,
+// so that it can be used by name, e.g., in split.AggName, along with the
+// standard aggregations (Count, Sum, Mean, etc), which are registered by
+// default under their Aggs name without the Agg prefix.  Names are case
+// insensitive, and registering an existing name replaces its function.
+// The function should return nil if the column is not found.
+func Register(name string, fn IndexViewAggFunc) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	key := strings.ToLower(name)
+	registry[key] = fn
+	registryNames[key] = name
+}
+
+// ByName returns the registered aggregation function of given name
+// (case insensitive), and false if not found.
+func ByName(name string) (IndexViewAggFunc, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	fn, ok := registry[strings.ToLower(name)]
+	return fn, ok
+}
+
+// RegisteredName returns the name as registered for given name
+// (case insensitive), e.g., Mean for mean, and false if not found.
+func RegisteredName(name string) (string, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	nm, ok := registryNames[strings.ToLower(name)]
+	return nm, ok
+}
+
+// RegisteredNames returns the sorted names of all the registered
+// aggregation functions.
+func RegisteredNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	nms := make([]string, 0, len(registryNames))
+	for _, nm := range registryNames {
+		nms = append(nms, nm)
+	}
+	sort.Strings(nms)
+	return nms
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/minmax"
+)
+
+// RunningRange incrementally tracks the minimum and maximum of values
+// as they are added, without re-scanning prior values, e.g., for
+// growing plot axis ranges as new data arrives.  NaN values are skipped.
+// The zero value is ready to use.
+type RunningRange struct {
+
+	// range of values added so far -- only valid if N > 0
+	Range minmax.F64
+
+	// number of values added so far
+	N int
+}
+
+// Reset resets the range so no values have been added.
+func (rr *RunningRange) Reset() {
+	rr.N = 0
+	rr.Range.SetInfinity()
+}
+
+// Add adds given value to the range, returning true if the range changed.
+// NaN values are ignored.
+func (rr *RunningRange) Add(val float64) bool {
+	if math.IsNaN(val) {
+		return false
+	}
+	if rr.N == 0 {
+		rr.Range.Set(val, val)
+		rr.N = 1
+		return true
+	}
+	rr.N++
+	return rr.Range.FitValInRange(val)
+}
+
+// Min returns the minimum value added so far, or NaN if none.
+func (rr *RunningRange) Min() float64 {
+	if rr.N == 0 {
+		return math.NaN()
+	}
+	return rr.Range.Min
+}
+
+// Max returns the maximum value added so far, or NaN if none.
+func (rr *RunningRange) Max() float64 {
+	if rr.N == 0 {
+		return math.NaN()
+	}
+	return rr.Range.Max
+}
+
+// RunningRangeCol is a RunningRange over all the non-Null, non-NaN values
+// in a column of an etable.Table, which is updated incrementally with
+// the values of rows as they are appended to the table.
+type RunningRangeCol struct {
+	RunningRange
+
+	// table containing the column
+	Table *etable.Table
+
+	// index of the column in the table
+	ColIndex int
+
+	// next row in the table to add on Update -- rows before this have
+	// already been added
+	NextRow int
+}
+
+// NewRunningRangeColIndex returns a new RunningRangeCol for given column index,
+// initialized with the values in the rows of given IndexView, and tracking
+// any rows appended to the Table after this point, via Update.
+func NewRunningRangeColIndex(ix *etable.IndexView, colIndex int) *RunningRangeCol {
+	rc := &RunningRangeCol{Table: ix.Table, ColIndex: colIndex, NextRow: ix.Table.Rows}
+	col := ix.Table.Cols[colIndex]
+	_, csz := col.RowCellSize()
+	for _, row := range ix.Indexes {
+		rc.addRow(col, row, csz)
+	}
+	return rc
+}
+
+// NewRunningRangeCol returns a new RunningRangeCol for given column name,
+// initialized with the values in the rows of given IndexView, and tracking
+// any rows appended to the Table after this point, via Update.
+// If name not found, nil is returned.
+func NewRunningRangeCol(ix *etable.IndexView, colNm string) *RunningRangeCol {
+	colIndex := ix.Table.ColIndex(colNm)
+	if colIndex == -1 {
+		return nil
+	}
+	return NewRunningRangeColIndex(ix, colIndex)
+}
+
+// Update adds the values of any rows appended to the table since the last
+// Update (or creation), returning true if the range changed.  It should be
+// called after new rows have been filled in, e.g., just prior to updating
+// a plot, as values set after a row has been added here are not seen.
+// If the table has fewer rows than before (e.g., it was reset), the range
+// is recomputed from all of its rows.
+func (rc *RunningRangeCol) Update() bool {
+	dt := rc.Table
+	changed := false
+	if dt.Rows < rc.NextRow {
+		rc.Reset()
+		rc.NextRow = 0
+		changed = true
+	}
+	col := dt.Cols[rc.ColIndex]
+	_, csz := col.RowCellSize()
+	for ; rc.NextRow < dt.Rows; rc.NextRow++ {
+		if rc.addRow(col, rc.NextRow, csz) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// addRow adds the non-Null values of given row, returning true if changed
+func (rc *RunningRangeCol) addRow(col etensor.Tensor, row, csz int) bool {
+	changed := false
+	si := row * csz
+	for j := 0; j < csz; j++ {
+		if col.IsNull1D(si + j) {
+			continue
+		}
+		if rc.Add(col.FloatValue1D(si + j)) {
+			changed = true
+		}
+	}
+	return changed
+}
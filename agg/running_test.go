@@ -0,0 +1,46 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestRunningRange(t *testing.T) {
+	var rr RunningRange
+	if !math.IsNaN(rr.Min()) || !math.IsNaN(rr.Max()) {
+		t.Error("RunningRange: expected NaN with no values")
+	}
+	for _, v := range []float64{3, -1, math.NaN(), 7, 2} {
+		rr.Add(v)
+	}
+	if rr.Min() != -1 || rr.Max() != 7 || rr.N != 4 {
+		t.Errorf("RunningRange: min %v max %v n %d != -1 7 4", rr.Min(), rr.Max(), rr.N)
+	}
+
+	dt := etable.New(etable.Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 3)
+	for i := 0; i < 3; i++ {
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	rc := NewRunningRangeCol(etable.NewIndexView(dt), "Val")
+	if rc.Min() != 0 || rc.Max() != 2 {
+		t.Errorf("RunningRangeCol: min %v max %v != 0 2", rc.Min(), rc.Max())
+	}
+	if rc.Update() {
+		t.Error("RunningRangeCol: Update with no new rows should not change")
+	}
+	dt.AddRows(2)
+	dt.SetCellFloat("Val", 3, -5)
+	dt.SetCellFloat("Val", 4, 10)
+	if !rc.Update() || rc.Min() != -5 || rc.Max() != 10 {
+		t.Errorf("RunningRangeCol: after append min %v max %v != -5 10", rc.Min(), rc.Max())
+	}
+}
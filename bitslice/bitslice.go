@@ -126,7 +126,7 @@ func (bs *Slice) SetAll(val bool) {
 
 // ToBools converts to a []bool slice
 func (bs *Slice) ToBools() []bool {
-	ln := len(*bs)
+	ln := bs.Len()
 	bb := make([]bool, ln)
 	for i := 0; i < ln; i++ {
 		bb[i] = bs.Index(i)
@@ -28,6 +28,10 @@ func TestBitSlice10(t *testing.T) {
 	// fmt.Printf("2=true: %v\n", bs.String())
 	ex = "[0 0 1 0 0 0 0 0 0 0 ]"
 	out = bs.String()
+	bb := bs.ToBools()
+	if len(bb) != 10 || !bb[2] || bb[3] {
+		t.Errorf("ToBools: %v\n", bb)
+	}
 	if out != ex {
 		t.Errorf("2=true != %v", out)
 	}
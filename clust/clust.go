@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 
 	"cogentcore.org/core/gox/indent"
 	"github.com/emer/etable/v2/etensor"
@@ -30,6 +31,9 @@ type Node struct {
 	// y-axis value for this node -- if a parent, it is the average of its kids Y's, otherwise it counts down
 	Y float64
 
+	// merge step at which this node was created by clustering, starting at 1 for the first merge -- is 0 for leaf nodes
+	Step int
+
 	// child nodes under this one
 	Kids []*Node
 }
@@ -63,6 +67,30 @@ func (nn *Node) Indexes(ix []int, ctr *int) {
 	}
 }
 
+// Merges returns all of the non-leaf nodes under this one
+// in the order in which they were merged, per their Step values,
+// along with the merge distance in each Dist value.
+func (nn *Node) Merges() []*Node {
+	var ms []*Node
+	nn.merges(&ms)
+	sort.Slice(ms, func(i, j int) bool {
+		return ms[i].Step < ms[j].Step
+	})
+	return ms
+}
+
+func (nn *Node) merges(ms *[]*Node) {
+	if nn.IsLeaf() {
+		return
+	}
+	if nn.Step > 0 {
+		*ms = append(*ms, nn)
+	}
+	for _, kn := range nn.Kids {
+		kn.merges(ms)
+	}
+}
+
 // NewNode merges two nodes into a new node
 func NewNode(na, nb *Node, dst float64) *Node {
 	nn := &Node{Dist: dst}
@@ -91,6 +119,15 @@ func GlomStd(smat *simat.SimMat, std StdDists) *Node {
 	return Glom(smat, StdFunc(std))
 }
 
+// GlomTensor implements basic agglomerative clustering directly on a
+// square distance matrix, e.g., as returned by pca.DistMatrix, using
+// given standard distance function (linkage).  Labels are not available
+// in this case -- use Glom with a SimMat to have labeled rows.
+func GlomTensor(dmat *etensor.Float64, std StdDists) *Node {
+	smat := &simat.SimMat{Mat: dmat}
+	return GlomStd(smat, std)
+}
+
 // GlomInit returns a standard root node initialized with all of the leaves
 func GlomInit(ntot int) *Node {
 	root := &Node{}
@@ -112,6 +149,7 @@ func GlomClust(root *Node, smat *simat.SimMat, dfunc DistFunc) *Node {
 	// indexes in each group
 	aidx := make([]int, ntot)
 	bidx := make([]int, ntot)
+	step := 0
 	for {
 		var ma, mb []int
 		mval := math.MaxFloat64
@@ -143,6 +181,8 @@ func GlomClust(root *Node, smat *simat.SimMat, dfunc DistFunc) *Node {
 		nb := mb[ni]
 		// fmt.Printf("merging nodes at dist: %v: %v and %v\nA: %v\nB: %v\n", mval, na, nb, root.Kids[na].Sprint(smat, 0), root.Kids[nb].Sprint(smat, 0))
 		nn := NewNode(root.Kids[na], root.Kids[nb], mval)
+		step++
+		nn.Step = step
 		for i := len(root.Kids) - 1; i >= 0; i-- {
 			if i == na || i == nb {
 				root.Kids = append(root.Kids[:i], root.Kids[i+1:]...)
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
 	"github.com/emer/etable/v2/metric"
 	"github.com/emer/etable/v2/simat"
 )
@@ -29,3 +30,34 @@ func TestClust(t *testing.T) {
 	s := cl.Sprint(smat, 0)
 	fmt.Println(s)
 }
+
+func TestGlomTensor(t *testing.T) {
+	// two tight pairs: (0,1) at 1, (2,3) at 2, far apart from each other
+	dmat := etensor.NewFloat64([]int{4, 4}, nil, nil)
+	dmat.SetFloats([]float64{
+		0, 1, 10, 12,
+		1, 0, 11, 10,
+		10, 11, 0, 2,
+		12, 10, 2, 0,
+	})
+	root := GlomTensor(dmat, Max)
+	ms := root.Merges()
+	if len(ms) != 3 {
+		t.Fatalf("GlomTensor: number of merges: %d != 3", len(ms))
+	}
+	dists := []float64{1, 2, 12}
+	for i, m := range ms {
+		if m.Step != i+1 {
+			t.Errorf("GlomTensor: merge %d Step: %d != %d", i, m.Step, i+1)
+		}
+		if m.Dist != dists[i] {
+			t.Errorf("GlomTensor: merge %d Dist: %v != %v", i, m.Dist, dists[i])
+		}
+	}
+	ix := make([]int, 2)
+	ctr := 0
+	ms[0].Indexes(ix, &ctr)
+	if !(ix[0] == 1 && ix[1] == 0) && !(ix[0] == 0 && ix[1] == 1) {
+		t.Errorf("GlomTensor: first merge leaves: %v != [0 1]", ix)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/emer/etable/v2/agg"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/split"
+)
+
+// AggregateTable returns the aggregated view of the given table used when
+// PlotParams.Aggregate is set: rows are grouped by the XAxisCol values, and
+// the AggGroupCol values if set, and the Mean and Sem (standard error of the
+// mean) across the rows in each group are computed for the X column and each
+// column that is turned On, e.g., to plot the mean across runs instead of
+// each individual run.  The returned plot params and column params are
+// copies configured to plot the Mean columns, with the Sem columns as error
+// bars and AggGroupCol as the LegendCol, and the original labels, colors and
+// other settings.
+func AggregateTable(ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (*etable.IndexView, *PlotParams, []*ColParams, error) {
+	dt := ix.Table
+	xi, err := dt.ColIndexTry(pp.XAxisCol)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("eplot.AggregateTable: XAxisCol is required: %w", err)
+	}
+	gcols := []string{pp.XAxisCol}
+	if pp.AggGroupCol != "" {
+		if _, err := dt.ColIndexTry(pp.AggGroupCol); err != nil {
+			return nil, nil, nil, fmt.Errorf("eplot.AggregateTable: AggGroupCol: %w", err)
+		}
+		gcols = append(gcols, pp.AggGroupCol)
+	}
+	spl, err := split.GroupByTry(ix, gcols)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if spl == nil || len(spl.Splits) == 0 {
+		return nil, nil, nil, errors.New("eplot.AggregateTable: no rows to aggregate")
+	}
+	split.AggIndex(spl, xi, agg.AggMean)
+	var ycols []*ColParams
+	for ci, cp := range cols {
+		if !cp.On || ci == xi || cp.IsString || cp.Col == pp.AggGroupCol {
+			continue
+		}
+		split.AggIndex(spl, ci, agg.AggMean)
+		split.AggIndex(spl, ci, agg.AggSem)
+		ycols = append(ycols, cp)
+	}
+	at := spl.AggsToTable(etable.AddAggName)
+
+	app := &PlotParams{}
+	*app = *pp
+	app.Aggregate = false
+	app.TracesCol = "" // not used with Aggregate
+	app.XAxisCol = pp.XAxisCol + ":Mean"
+	app.LegendCol = pp.AggGroupCol
+
+	acols := make([]*ColParams, at.NumCols())
+	for ci, cn := range at.ColNames {
+		acp := &ColParams{Col: cn}
+		acp.Defaults()
+		acols[ci] = acp
+	}
+	ci := len(spl.Levels)
+	for _, acp := range acols[:ci] { // group level columns, as strings
+		acp.IsString = true
+	}
+	xacp := acols[ci]
+	xacp.CopyFrom(cols[xi])
+	xacp.Col = app.XAxisCol
+	xacp.Lbl = cols[xi].Label()
+	ci++
+	for _, cp := range ycols {
+		mcp := acols[ci]
+		mcp.CopyFrom(cp)
+		mcp.Col = cp.Col + ":Mean"
+		mcp.Lbl = cp.Label()
+		mcp.ErrCol = cp.Col + ":Sem"
+		ci += 2 // Sem column is Off
+	}
+	return etable.NewIndexView(at), app, acols, nil
+}
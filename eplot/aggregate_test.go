@@ -0,0 +1,101 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestAggregate(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Cond", etensor.STRING, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 12)
+	ri := 0
+	for run := 0; run < 2; run++ {
+		for _, cond := range []string{"A", "B"} {
+			for ep := 0; ep < 3; ep++ {
+				dt.SetCellFloat("Run", ri, float64(run))
+				dt.SetCellString("Cond", ri, cond)
+				dt.SetCellFloat("Epoch", ri, float64(ep))
+				dt.SetCellFloat("Err", ri, float64(ep+run))
+				ri++
+			}
+		}
+	}
+	pp := &PlotParams{XAxisCol: "Epoch", Aggregate: true}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[3].On = true
+	cols[3].Lbl = "Error"
+	ix := etable.NewIndexView(dt)
+
+	aix, app, acols, err := AggregateTable(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := aix.Table
+	if at.Rows != 3 || app.XAxisCol != "Epoch:Mean" || app.Aggregate {
+		t.Fatalf("AggregateTable: rows %d X %s", at.Rows, app.XAxisCol)
+	}
+	// each Epoch has 4 values: 2 runs x 2 conds, 2 of each of ep and ep+1,
+	// so the sample std is 1/sqrt(3) and the sem is std / sqrt(4)
+	sem := 1 / (2 * math.Sqrt(3))
+	for row := 0; row < 3; row++ {
+		if m := at.CellFloat("Err:Mean", row); m != float64(row)+.5 {
+			t.Errorf("AggregateTable mean row %d: %v", row, m)
+		}
+		if s := at.CellFloat("Err:Sem", row); math.Abs(s-sem) > 1e-9 {
+			t.Errorf("AggregateTable sem row %d: %v", row, s)
+		}
+	}
+	mcp := acols[at.ColIndex("Err:Mean")]
+	if !mcp.On || mcp.ErrCol != "Err:Sem" || mcp.Label() != "Error" {
+		t.Errorf("AggregateTable Mean col params: %+v", mcp)
+	}
+	if app.XLabel(acols) != "Epoch" {
+		t.Errorf("AggregateTable X label: %s", app.XLabel(acols))
+	}
+
+	plt, err := GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Errorf("GenPlotXY Aggregate: legend entries: %v, expected 1", lg)
+	}
+	if plt.Y.Max < 2.5+sem-1e-9 { // max mean + sem
+		t.Errorf("GenPlotXY Aggregate: Y max %v does not include error bars", plt.Y.Max)
+	}
+	if pp.XAxisCol != "Epoch" || !pp.Aggregate {
+		t.Error("GenPlotXY Aggregate should not modify the plot params")
+	}
+
+	pp.AggGroupCol = "Cond"
+	aix, app, _, err = AggregateTable(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aix.Table.Rows != 6 || app.LegendCol != "Cond" {
+		t.Errorf("AggregateTable AggGroupCol: rows %d legend %s", aix.Table.Rows, app.LegendCol)
+	}
+	plt, err = GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 2 {
+		t.Errorf("GenPlotXY AggGroupCol: legend entries: %v, expected 2", lg)
+	}
+	pp.AggGroupCol = "Nope"
+	if _, err := GenPlotXY(ix, pp, cols); err == nil {
+		t.Error("expected error for missing AggGroupCol")
+	}
+}
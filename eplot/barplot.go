@@ -10,7 +10,9 @@ import (
 	"math"
 
 	"cogentcore.org/core/colors"
+	"cogentcore.org/core/errors"
 	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
 	"github.com/emer/etable/v2/minmax"
 	"github.com/emer/etable/v2/split"
 	"gonum.org/v1/plot"
@@ -23,33 +25,67 @@ import (
 
 // GenPlotBar generates a Bar plot, setting GPlot variable
 func (pl *Plot2D) GenPlotBar() {
+	if pl.Params.BarWidth > 1 {
+		pl.Params.BarWidth = .8
+	}
+	plt, err := GenPlotBar(pl.Table, &pl.Params, pl.Cols)
+	if err != nil {
+		return
+	}
+	pl.Plot = plt
+	if pl.ConfigPlotFunc != nil {
+		pl.ConfigPlotFunc()
+	}
+}
+
+// GenPlotBar generates a Bar gonum plot of the given IndexView of a table,
+// using given overall plot params and column params, which must have one
+// entry per column of the table (see NewColsParams).
+// It does not depend on the Plot2D widget or any GUI, so it can be used
+// to generate plots headlessly, e.g., for saving to SVG or PNG files.
+func GenPlotBar(ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (*plot.Plot, error) {
+	if ix == nil || ix.Table == nil {
+		return nil, errors.New("eplot.GenPlotBar: table is nil")
+	}
+	if len(cols) != ix.Table.NumCols() {
+		return nil, fmt.Errorf("eplot.GenPlotBar: number of column params: %d != number of table columns: %d", len(cols), ix.Table.NumCols())
+	}
+	if pp.Aggregate {
+		aix, app, acols, err := AggregateTable(ix, pp, cols)
+		if err != nil {
+			return nil, err
+		}
+		ix, pp, cols = aix, app, acols
+	}
+	dt := ix.Table
 	plt := plot.New() // note: not clear how to re-use, due to newtablexynames
-	plt.Title.Text = pl.Params.Title
-	plt.X.Label.Text = pl.XLabel()
-	plt.Y.Label.Text = pl.YLabel()
+	plt.Title.Text = pp.Title
+	plt.X.Label.Text = pp.XLabel(cols)
+	plt.Y.Label.Text = pp.YLabel(cols)
 	// TODO(kai): better bar plot styling
 	plt.BackgroundColor = colors.Scheme.Surface
 
-	if pl.Params.BarWidth > 1 {
-		pl.Params.BarWidth = .8
+	barWidth := pp.BarWidth
+	if barWidth > 1 {
+		barWidth = .8
 	}
 
 	// process xaxis first
-	xi, xview, _, err := pl.PlotXAxis(plt, pl.Table)
+	xi, xview, _, err := PlotXAxis(plt, ix, pp, cols)
 	if err != nil {
-		return
+		return nil, err
 	}
-	xp := pl.Cols[xi]
+	xp := cols[xi]
 
 	var lsplit *etable.Splits
 	nleg := 1
-	if pl.Params.LegendCol != "" {
-		_, err = pl.Table.Table.ColIndexTry(pl.Params.LegendCol)
+	if pp.LegendCol != "" {
+		_, err = dt.ColIndexTry(pp.LegendCol)
 		if err != nil {
 			log.Println("eplot.LegendCol: " + err.Error())
 		} else {
-			xview.SortColNames([]string{pl.Params.LegendCol, xp.Col}, etable.Ascending) // make it fit!
-			lsplit = split.GroupBy(xview, []string{pl.Params.LegendCol})
+			xview.SortColNames([]string{pp.LegendCol, xp.Col}, etable.Ascending) // make it fit!
+			lsplit = split.GroupBy(xview, []string{pp.LegendCol})
 			nleg = max(lsplit.Len(), 1)
 		}
 	}
@@ -57,16 +93,18 @@ func (pl *Plot2D) GenPlotBar() {
 	var firstXY *TableXY
 	var strCols []*ColParams
 	nys := 0
-	for _, cp := range pl.Cols {
+	for _, cp := range cols {
 		if !cp.On {
 			continue
 		}
 		if cp.IsString {
-			strCols = append(strCols, cp)
+			if cp != xp { // string X axis values are the tick labels
+				strCols = append(strCols, cp)
+			}
 			continue
 		}
 		if cp.TensorIndex < 0 {
-			yc := pl.Table.Table.ColByName(cp.Col)
+			yc := dt.ColByName(cp.Col)
 			_, sz := yc.RowCellSize()
 			nys += sz
 		} else {
@@ -81,8 +119,9 @@ func (pl *Plot2D) GenPlotBar() {
 	}
 
 	if nys == 0 {
-		return
+		return nil, errors.New("eplot.GenPlotBar: no columns to plot")
 	}
+	pp.AddTarget(plt)
 
 	stride := nys * nleg
 	if stride > 1 {
@@ -92,7 +131,7 @@ func (pl *Plot2D) GenPlotBar() {
 	yoff := 0
 	yidx := 0
 	maxx := 0 // max number of x values
-	for _, cp := range pl.Cols {
+	for _, cp := range cols {
 		if !cp.On || cp == xp {
 			continue
 		}
@@ -100,6 +139,7 @@ func (pl *Plot2D) GenPlotBar() {
 			continue
 		}
 		start := yoff
+		ec := barErrCol(dt, cp)
 		for li := 0; li < nleg; li++ {
 			lview := xview
 			leg := ""
@@ -110,7 +150,7 @@ func (pl *Plot2D) GenPlotBar() {
 			nidx := 1
 			stidx := cp.TensorIndex
 			if cp.TensorIndex < 0 { // do all
-				yc := pl.Table.Table.ColByName(cp.Col)
+				yc := dt.ColByName(cp.Col)
 				_, sz := yc.RowCellSize()
 				nidx = sz
 				stidx = 0
@@ -138,31 +178,30 @@ func (pl *Plot2D) GenPlotBar() {
 					clr = colors.Spaced(idx)
 					lbl = fmt.Sprintf("%s_%02d", lbl, idx)
 				}
-				ec := -1
-				if cp.ErrCol != "" {
-					ec = pl.Table.Table.ColIndex(cp.ErrCol)
-				}
-				var bar *ErrBarChart
+				var exy plotter.Valuer
 				if ec >= 0 {
-					exy, _ := NewTableXY(lview, ec, 0, ec, 0, minmax.Range64{})
-					bar, err = NewErrBarChart(xy, exy)
-					if err != nil {
-						log.Println(err)
-						continue
-					}
-				} else {
-					bar, err = NewErrBarChart(xy, nil)
-					if err != nil {
-						log.Println(err)
-						continue
+					eidx := 0
+					if dt.Cols[ec].NumDims() > 1 { // same cell index as values
+						eidx = idx
 					}
+					exy, _ = NewTableXY(lview, ec, 0, ec, eidx, minmax.Range64{})
+				}
+				bar, err := NewErrBarChart(xy, exy)
+				if err != nil {
+					log.Println(err)
+					continue
 				}
 				bar.Color = clr
 				bar.Stride = float64(stride)
 				bar.Start = float64(start)
-				bar.Width = pl.Params.BarWidth
+				bar.Width = barWidth
 				plt.Add(bar)
 				plt.Legend.Add(lbl, bar)
+				if pp.BarValueLabels {
+					if vl := barValueLabels(bar, pp.YTickFormat); vl != nil {
+						plt.Add(vl)
+					}
+				}
 				start++
 			}
 		}
@@ -199,25 +238,115 @@ func (pl *Plot2D) GenPlotBar() {
 		}
 	}
 
-	netn := pl.Table.Len() * stride
-	xc := pl.Table.Table.Cols[xi]
+	// X tick labels are the X column values (e.g., category names for a
+	// string column) at each bar position, which are the same across legend values
+	lview := xview
+	if lsplit != nil && len(lsplit.Splits) > 0 {
+		lview = lsplit.Splits[0]
+		for _, sp := range lsplit.Splits[1:] {
+			if sp.Len() > lview.Len() {
+				lview = sp
+			}
+		}
+	}
+	netn := lview.Len() * stride
+	xc := dt.Cols[xi]
 	vals := make([]string, netn)
-	for i, dx := range pl.Table.Indexes {
+	for i, dx := range lview.Indexes {
 		pi := mid + i*stride
 		if pi < netn && dx < xc.Len() {
 			vals[pi] = xc.StringValue1D(dx)
 		}
 	}
 	plt.NominalX(vals...)
+	pp.PadRanges(plt, cols, -1) // X is ordinal
+	pp.ConfigTicks(plt, true)
 
 	plt.Legend.Top = true
-	plt.X.Tick.Label.Rotation = math.Pi * (pl.Params.XAxisRot / 180)
-	if pl.Params.XAxisRot > 10 {
+	xrot := pp.XAxisRot
+	if xrot == 0 && xc.DataType() == etensor.STRING && barLabelsOverlap(vals) {
+		xrot = 45
+	}
+	plt.X.Tick.Label.Rotation = math.Pi * (xrot / 180)
+	if xrot > 10 {
 		plt.X.Tick.Label.YAlign = draw.YCenter
 		plt.X.Tick.Label.XAlign = draw.XRight
 	}
-	pl.Plot = plt
-	if pl.ConfigPlotFunc != nil {
-		pl.ConfigPlotFunc()
+	return plt, nil
+}
+
+// barLabelsOverlap returns true if the given X axis tick labels are likely
+// to overlap at a typical plot width, based on the number of characters
+// in the longest label, as each label gets an equal amount of space.
+func barLabelsOverlap(lbls []string) bool {
+	n, mx := 0, 0
+	for _, lb := range lbls {
+		if lb != "" {
+			n++
+			mx = max(mx, len(lb))
+		}
+	}
+	return n*(mx+2) > 80
+}
+
+// barErrCol returns the index of the ErrCol of given column params for
+// a bar plot, or -1 if it is not set, or is not a valid numeric column,
+// which is logged.  The error values are taken from the same rows as
+// the bar values, so it must be aligned row-for-row with the column,
+// e.g., a Sem column from split aggregation of the same splits as the
+// Mean column.
+func barErrCol(dt *etable.Table, cp *ColParams) int {
+	if cp.ErrCol == "" {
+		return -1
+	}
+	ec, err := dt.ColIndexTry(cp.ErrCol)
+	if err != nil {
+		log.Println("eplot.ErrCol: " + err.Error())
+		return -1
+	}
+	if dt.Cols[ec].DataType() == etensor.STRING {
+		log.Printf("eplot.ErrCol: error column: %q for column: %q must be numeric\n", cp.ErrCol, cp.Col)
+		return -1
+	}
+	return ec
+}
+
+// barValueLabels returns text labels showing the value of each bar of
+// given bar chart, formatted with given fmt-style format (%g if empty),
+// positioned at the end of each bar beyond any error bar: above positive
+// values and below negative values.  NaN values are not labeled, and
+// nil is returned if there are no values to label.
+func barValueLabels(bar *ErrBarChart, format string) *plotter.Labels {
+	if format == "" {
+		format = "%g"
+	}
+	xyl := plotter.XYLabels{}
+	for i, v := range bar.Values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		y := v
+		if i < len(bar.Errors) && !math.IsNaN(bar.Errors[i]) {
+			y += bar.errorExtent(i)
+		}
+		xyl.XYs = append(xyl.XYs, plotter.XY{X: bar.Start + float64(i)*bar.Stride, Y: y})
+		xyl.Labels = append(xyl.Labels, fmt.Sprintf(format, v))
+	}
+	if len(xyl.Labels) == 0 {
+		return nil
+	}
+	lbls, err := plotter.NewLabels(xyl)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	for i, xy := range xyl.XYs {
+		lbls.TextStyle[i].XAlign = draw.XCenter
+		if xy.Y < 0 {
+			lbls.TextStyle[i].YAlign = draw.YTop
+		} else {
+			lbls.TextStyle[i].YAlign = draw.YBottom
+		}
 	}
+	return lbls
 }
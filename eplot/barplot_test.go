@@ -0,0 +1,212 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+func TestGenPlotBar(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+		{"RT", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellString("Cond", ri, string(rune('A'+ri)))
+		dt.SetCellFloat("Err", ri, 0.1*float64(ri))
+		dt.SetCellFloat("RT", ri, 100+10*float64(ri))
+	}
+	pp := &PlotParams{Type: Bar, XAxisCol: "Cond", YAxisLabel: "Value"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	cols[2].On = true
+
+	plt, err := GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 2 {
+		t.Errorf("GenPlotBar: legend entries: %v, expected 2", lg)
+	}
+	if plt.X.Label.Text != "Cond" {
+		t.Errorf("GenPlotBar: X label: %v != Cond", plt.X.Label.Text)
+	}
+	if plt.Y.Label.Text != "Value" {
+		t.Errorf("GenPlotBar: Y label: %v != Value", plt.Y.Label.Text)
+	}
+
+	cols[2].On = false
+	plt, err = GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Errorf("GenPlotBar: legend entries: %v, expected 1", lg)
+	}
+
+	_, err = GenPlotBar(etable.NewIndexView(dt), pp, cols[:1])
+	if err == nil {
+		t.Error("GenPlotBar: expected error with mismatched column params")
+	}
+}
+
+func TestGenPlotBarStringX(t *testing.T) {
+	cats := []string{"Control", "Drug", "Placebo"}
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"RT", etensor.FLOAT64, nil, nil},
+	}, len(cats))
+	for ri, c := range cats {
+		dt.SetCellString("Cond", ri, c)
+		dt.SetCellFloat("RT", ri, 100+10*float64(ri))
+	}
+	pp := &PlotParams{Type: Bar, XAxisCol: "Cond"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[0].On = true // string x axis is not also drawn as bar labels
+	cols[1].On = true
+
+	plt, err := GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Errorf("GenPlotBar string X: legend entries: %v, expected 1", lg)
+	}
+	var lbls []string
+	for _, tk := range plt.X.Tick.Marker.Ticks(plt.X.Min, plt.X.Max) {
+		if tk.Label != "" {
+			lbls = append(lbls, tk.Label)
+		}
+	}
+	if !slices.Equal(lbls, cats) {
+		t.Errorf("GenPlotBar string X tick labels: %v != %v", lbls, cats)
+	}
+	if plt.X.Tick.Label.Rotation != 0 {
+		t.Errorf("GenPlotBar string X: short labels should not be rotated")
+	}
+
+	dt.SetCellString("Cond", 1, "A much longer condition name that will overlap the others")
+	plt, _ = GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if plt.X.Tick.Label.Rotation == 0 {
+		t.Errorf("GenPlotBar string X: long labels should be rotated")
+	}
+}
+
+func TestGenPlotBarErrCol(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"RT:Mean", etensor.FLOAT64, nil, nil},
+		{"RT:Sem", etensor.FLOAT64, nil, nil},
+	}, 3)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellString("Cond", ri, string(rune('A'+ri)))
+		dt.SetCellFloat("RT:Mean", ri, 100+10*float64(ri))
+		dt.SetCellFloat("RT:Sem", ri, 50)
+	}
+	pp := &PlotParams{Type: Bar, XAxisCol: "Cond"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+
+	plt, err := GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nomax := plt.Y.Max
+
+	cols[1].ErrCol = "RT:Sem"
+	plt, err = GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Errorf("GenPlotBar ErrCol: legend entries: %v, expected 1", lg)
+	}
+	if plt.Y.Max <= nomax || plt.Y.Max < 170 {
+		t.Errorf("GenPlotBar ErrCol: Y max: %v does not include error bars (without: %v)", plt.Y.Max, nomax)
+	}
+
+	cols[1].ErrCol = "Cond" // not numeric: ignored
+	plt, err = GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plt.Y.Max != nomax {
+		t.Errorf("GenPlotBar ErrCol: Y max with String error column: %v != %v", plt.Y.Max, nomax)
+	}
+}
+
+func TestErrBarChartDataRange(t *testing.T) {
+	b, err := NewErrBarChart(plotter.Values{2, -3, 1}, plotter.Values{.5, 1, math.NaN()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, ymin, ymax := b.DataRange()
+	if ymin != -4 || ymax != 2.5 {
+		t.Errorf("ErrBarChart DataRange: y: %v, %v != -4, 2.5", ymin, ymax)
+	}
+}
+
+func TestBarValueLabels(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Acc", etensor.FLOAT64, nil, nil},
+	}, 3)
+	vals := []float64{0.25, 0.5, -0.2}
+	for ri, v := range vals {
+		dt.SetCellString("Cond", ri, string(rune('A'+ri)))
+		dt.SetCellFloat("Acc", ri, v)
+	}
+	pp := &PlotParams{Type: Bar, XAxisCol: "Cond", YTickFormat: "%.2f"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+
+	plt, err := GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acts, _ := drawPlot(plt)
+	if slices.Contains(drawnStrings(acts), "0.25") {
+		t.Error("BarValueLabels off: value label drawn")
+	}
+	pp.BarValueLabels = true
+	plt, err = GenPlotBar(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acts, _ = drawPlot(plt)
+	if !slices.Contains(drawnStrings(acts), "0.25") {
+		t.Error("BarValueLabels: value label not drawn")
+	}
+
+	bar, err := NewErrBarChart(plotter.Values(vals), plotter.Values{0.1, 0, 0.1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lbls := barValueLabels(bar, pp.YTickFormat)
+	if lbls == nil {
+		t.Fatal("barValueLabels: nil labels")
+	}
+	if exp := []string{"0.25", "0.50", "-0.20"}; !slices.Equal(lbls.Labels, exp) {
+		t.Errorf("barValueLabels: %v != %v", lbls.Labels, exp)
+	}
+	if math.Abs(lbls.XYs[0].Y-0.35) > 1e-9 || math.Abs(lbls.XYs[2].Y+0.3) > 1e-9 {
+		t.Errorf("barValueLabels: Y positions: %v, %v != 0.35, -0.3", lbls.XYs[0].Y, lbls.XYs[2].Y)
+	}
+	if lbls.TextStyle[0].YAlign != draw.YBottom || lbls.TextStyle[2].YAlign != draw.YTop {
+		t.Error("barValueLabels: negative values should be labeled below the bar")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"github.com/emer/etable/v2/clust"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/simat"
+	"gonum.org/v1/plot"
+)
+
+// ClustTable returns a table that renders given cluster tree as a dendrogram,
+// using clust.Plot, with the leaf labels from the smat.Rows labels (e.g., from
+// a string column via simat.TableCol) and branch heights from the merge
+// distances.  Plot meta data is set on the table so that it renders properly
+// in a Plot2D or via GenPlotXY.
+func ClustTable(root *clust.Node, smat *simat.SimMat) *etable.Table {
+	pt := &etable.Table{}
+	clust.Plot(pt, root, smat)
+	pt.SetMetaData("name", "cluster")
+	pt.SetMetaData("XAxisCol", "X")
+	pt.SetMetaData("XAxisLabel", "Distance")
+	pt.SetMetaData("YAxisLabel", " ")
+	pt.SetMetaData("NegXDraw", "+")
+	pt.SetMetaData("Lines", "+")
+	pt.SetMetaData("Points", "-")
+	pt.SetMetaData("Y:On", "+")
+	pt.SetMetaData("Label:On", "+")
+	return pt
+}
+
+// SetClust sets the plot to display given cluster tree as a dendrogram,
+// using ClustTable, which can then be saved using SaveSVG or SavePNG.
+func (pl *Plot2D) SetClust(root *clust.Node, smat *simat.SimMat) *Plot2D {
+	return pl.SetTable(ClustTable(root, smat))
+}
+
+// GenPlotClust generates a dendrogram gonum plot of given cluster tree,
+// using ClustTable and GenPlotXY, without depending on the Plot2D widget.
+func GenPlotClust(root *clust.Node, smat *simat.SimMat) (*plot.Plot, error) {
+	pt := ClustTable(root, smat)
+	pp := &PlotParams{}
+	pp.Defaults()
+	pp.FromMeta(pt)
+	cols := NewColsParams(pt, pp)
+	return GenPlotXY(etable.NewIndexView(pt), pp, cols)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/emer/etable/v2/clust"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/simat"
+)
+
+func TestGenPlotClust(t *testing.T) {
+	dmat := etensor.NewFloat64([]int{3, 3}, nil, nil)
+	dmat.SetFloats([]float64{
+		0, 1, 4,
+		1, 0, 3,
+		4, 3, 0,
+	})
+	smat := &simat.SimMat{Mat: dmat, Rows: []string{"A", "B", "C"}}
+	root := clust.GlomStd(smat, clust.Avg)
+
+	pt := ClustTable(root, smat)
+	nlbl := 0
+	for ri := 0; ri < pt.Rows; ri++ {
+		if pt.CellString("Label", ri) != "" {
+			nlbl++
+		}
+	}
+	if nlbl != 3 {
+		t.Errorf("ClustTable: number of leaf labels: %d != 3", nlbl)
+	}
+
+	plt, err := GenPlotClust(root, smat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acts, _ := drawPlot(plt)
+	strs := drawnStrings(acts)
+	for _, lbl := range smat.Rows {
+		if !slices.Contains(strs, lbl) {
+			t.Errorf("GenPlotClust: leaf label %s not drawn", lbl)
+		}
+	}
+	if plt.X.Label.Text != "Distance" {
+		t.Errorf("GenPlotClust: X label: %v != Distance", plt.X.Label.Text)
+	}
+}
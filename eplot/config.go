@@ -0,0 +1,159 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"log"
+	"os"
+
+	"cogentcore.org/core/colors"
+	"cogentcore.org/core/core"
+)
+
+// PlotConfig is the full configuration of a Plot2D: the overall PlotParams
+// and the ColParams for each column.  It is saved and loaded as JSON by
+// Plot2D.SaveConfig and OpenConfig, independent of the data, so that the
+// same configuration can be applied to other data with the same column names.
+type PlotConfig struct {
+
+	// overall plot parameters
+	Params PlotParams
+
+	// parameters for each column, matched by Col name
+	Cols []*ColParams
+}
+
+// SaveConfig saves the full plot configuration (Params and Cols) to
+// given JSON file, which can be loaded later with OpenConfig.
+func (pl *Plot2D) SaveConfig(fname core.Filename) error { //types:add
+	pc := PlotConfig{Params: pl.Params, Cols: pl.Cols}
+	b, err := json.MarshalIndent(&pc, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(string(fname), b, 0666)
+}
+
+// OpenConfig opens the full plot configuration (Params and Cols) from given
+// JSON file, saved by SaveConfig, and updates the plot.  Column parameters
+// are applied to the columns with the same name in the current table --
+// any others are logged and skipped.
+func (pl *Plot2D) OpenConfig(fname core.Filename) error { //types:add
+	b, err := os.ReadFile(string(fname))
+	if err != nil {
+		return err
+	}
+	var pc PlotConfig
+	if err := json.Unmarshal(b, &pc); err != nil {
+		return err
+	}
+	pl.SetConfig(&pc)
+	return nil
+}
+
+// SetConfig applies given full plot configuration to this plot, with
+// column parameters applied to the columns with the same name in the
+// current table -- any others are logged and skipped.
+func (pl *Plot2D) SetConfig(pc *PlotConfig) {
+	pl.Params = pc.Params
+	pl.Params.Plot = pl
+	for _, ccp := range pc.Cols {
+		cp, err := pl.ColParamsTry(ccp.Col)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		isString := cp.IsString
+		*cp = *ccp
+		cp.IsString = isString
+		cp.Plot = pl
+	}
+	if len(pl.Kids) == 2 { // gui has been configured
+		pl.ColsUpdate()
+	}
+	pl.UpdatePlot()
+}
+
+// colorToJSON returns the #RRGGBBAA hex string of the raw (alpha-premultiplied)
+// RGBA components of given color, or "" if nil.  Unlike colors.AsHex, this
+// is lossless for a color.RGBA with channels above its alpha, as is
+// typical for a translucent TargetColor.
+func colorToJSON(clr color.Color) string {
+	if clr == nil {
+		return ""
+	}
+	c := color.RGBAModel.Convert(clr).(color.RGBA)
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// colorFromJSON returns the color for given string, as written by colorToJSON,
+// or any other color string (e.g., a color name), or nil if empty
+func colorFromJSON(str string) (color.Color, error) {
+	if str == "" {
+		return nil, nil
+	}
+	var c color.RGBA
+	if n, err := fmt.Sscanf(str, "#%02x%02x%02x%02x", &c.R, &c.G, &c.B, &c.A); err == nil && n == 4 && len(str) == 9 {
+		return c, nil
+	}
+	clr, err := colors.FromString(str)
+	if err != nil {
+		return nil, err
+	}
+	return clr, nil
+}
+
+// MarshalJSON encodes the column parameters as JSON, with the Color as a
+// hex string (e.g., #4285f4ff), as a color.Color cannot be decoded directly.
+func (cp *ColParams) MarshalJSON() ([]byte, error) {
+	type colParams ColParams
+	return json.Marshal(&struct {
+		*colParams
+		Color string
+	}{(*colParams)(cp), colorToJSON(cp.Color)})
+}
+
+// UnmarshalJSON decodes the column parameters from JSON, as encoded by MarshalJSON.
+func (cp *ColParams) UnmarshalJSON(b []byte) error {
+	type colParams ColParams
+	aux := &struct {
+		*colParams
+		Color string
+	}{colParams: (*colParams)(cp)}
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+	var err error
+	cp.Color, err = colorFromJSON(aux.Color)
+	return err
+}
+
+// MarshalJSON encodes the plot parameters as JSON, with the TargetColor as
+// a hex string (see colorToJSON), as a color.Color cannot be decoded directly.
+func (pp *PlotParams) MarshalJSON() ([]byte, error) {
+	type plotParams PlotParams
+	return json.Marshal(&struct {
+		*plotParams
+		TargetColor string
+	}{(*plotParams)(pp), colorToJSON(pp.TargetColor)})
+}
+
+// UnmarshalJSON decodes the plot parameters from JSON, as encoded by MarshalJSON.
+func (pp *PlotParams) UnmarshalJSON(b []byte) error {
+	type plotParams PlotParams
+	aux := &struct {
+		*plotParams
+		TargetColor string
+	}{plotParams: (*plotParams)(pp)}
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+	var err error
+	pp.TargetColor, err = colorFromJSON(aux.TargetColor)
+	return err
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"gonum.org/v1/plot/plotter"
+)
+
+// DownsampleXY returns at most maxPts points from given XYer, using
+// min / max preserving bucketing: the points are divided into maxPts / 2
+// buckets, and the points with the minimum and maximum Y values
+// in each bucket are kept, in their original order, so that spikes are
+// not lost.  Returns nil if no downsampling is needed, i.e., if maxPts <= 0
+// or there are no more than maxPts points.
+func DownsampleXY(xy plotter.XYer, maxPts int) plotter.XYs {
+	idx := downsampleIndexes(xy, maxPts)
+	if idx == nil {
+		return nil
+	}
+	ds := make(plotter.XYs, len(idx))
+	for i, pi := range idx {
+		ds[i].X, ds[i].Y = xy.XY(pi)
+	}
+	return ds
+}
+
+// downsampleIndexes returns the indexes of the points kept by DownsampleXY,
+// or nil if no downsampling is needed.
+func downsampleIndexes(xy plotter.XYer, maxPts int) []int {
+	n := xy.Len()
+	if maxPts <= 0 || n <= maxPts {
+		return nil
+	}
+	nb := max(maxPts/2, 1)
+	idx := make([]int, 0, 2*nb)
+	for b := 0; b < nb; b++ {
+		st := (b * n) / nb
+		ed := ((b + 1) * n) / nb
+		if st >= ed {
+			continue
+		}
+		mni, mxi := st, st
+		_, mnv := xy.XY(st)
+		mxv := mnv
+		for i := st + 1; i < ed; i++ {
+			_, y := xy.XY(i)
+			if y < mnv {
+				mni, mnv = i, y
+			}
+			if y > mxv {
+				mxi, mxv = i, y
+			}
+		}
+		fi, li := min(mni, mxi), max(mni, mxi)
+		idx = append(idx, fi)
+		if li != fi && len(idx) < maxPts {
+			idx = append(idx, li)
+		}
+	}
+	return idx
+}
+
+// downsampledXY is the subset of the points of a TableXY at the
+// indexes from downsampleIndexes, including their error values, so that
+// the error bars are downsampled with the same buckets as the lines.
+type downsampledXY struct {
+	xy  *TableXY
+	idx []int
+}
+
+// Len returns the number of points kept
+func (ds *downsampledXY) Len() int {
+	return len(ds.idx)
+}
+
+// XY returns the x, y pair of the i-th point kept
+func (ds *downsampledXY) XY(i int) (x, y float64) {
+	return ds.xy.XY(ds.idx[i])
+}
+
+// YError returns the error bars of the i-th point kept
+func (ds *downsampledXY) YError(i int) (float64, float64) {
+	return ds.xy.YError(ds.idx[i])
+}
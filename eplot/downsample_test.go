@@ -0,0 +1,81 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/minmax"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestDownsampleXY(t *testing.T) {
+	n := 1000
+	xy := make(plotter.XYs, n)
+	for i := range xy {
+		xy[i] = plotter.XY{X: float64(i), Y: float64(i % 7)}
+	}
+	xy[537].Y = 100
+	xy[901].Y = -50
+
+	if DownsampleXY(xy, 0) != nil || DownsampleXY(xy, n) != nil {
+		t.Errorf("DownsampleXY: should return nil when not needed")
+	}
+	ds := DownsampleXY(xy, 100)
+	if len(ds) == 0 || len(ds) > 100 {
+		t.Fatalf("DownsampleXY: len: %d not in (0, 100]", len(ds))
+	}
+	hasMax, hasMin := false, false
+	for i, p := range ds {
+		if i > 0 && p.X <= ds[i-1].X {
+			t.Errorf("DownsampleXY: X not increasing at %d: %v <= %v", i, p.X, ds[i-1].X)
+		}
+		if p.Y == 100 {
+			hasMax = true
+		}
+		if p.Y == -50 {
+			hasMin = true
+		}
+	}
+	if !hasMax || !hasMin {
+		t.Errorf("DownsampleXY: spikes not preserved: max: %v min: %v", hasMax, hasMin)
+	}
+}
+
+func TestDownsampleErrBars(t *testing.T) {
+	n := 1000
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, n)
+	for i := 0; i < n; i++ {
+		dt.SetCellFloat("X", i, float64(i))
+		dt.SetCellFloat("Y", i, float64(i%7))
+		dt.SetCellFloat("Err", i, float64(i))
+	}
+	xy, err := NewTableXYName(etable.NewIndexView(dt), 0, 0, "Y", 0, minmax.Range64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	xy.ErrCol = 2
+	idx := downsampleIndexes(xy, 100)
+	ds := DownsampleXY(xy, 100)
+	dxy := &downsampledXY{xy: xy, idx: idx}
+	if dxy.Len() != len(ds) {
+		t.Fatalf("downsampledXY: len: %d != DownsampleXY len: %d", dxy.Len(), len(ds))
+	}
+	for i, p := range ds {
+		x, y := dxy.XY(i)
+		if x != p.X || y != p.Y {
+			t.Errorf("downsampledXY %d: %v, %v != %v, %v", i, x, y, p.X, p.Y)
+		}
+		if lo, hi := dxy.YError(i); lo != -p.X || hi != p.X { // Err == X
+			t.Errorf("downsampledXY %d: YError: %v, %v != %v", i, lo, hi, p.X)
+		}
+	}
+}
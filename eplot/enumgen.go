@@ -87,3 +87,42 @@ func (i Shapes) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
 func (i *Shapes) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "Shapes") }
+
+var _TrendsValues = []Trends{0, 1, 2}
+
+// TrendsN is the highest valid value for type Trends, plus one.
+const TrendsN Trends = 3
+
+var _TrendsValueMap = map[string]Trends{`NoTrend`: 0, `Linear`: 1, `Poly2`: 2}
+
+var _TrendsDescMap = map[Trends]string{0: `NoTrend does not draw a fit line`, 1: `Linear draws a straight line fit: y = a + b x`, 2: `Poly2 draws a second-order polynomial fit: y = a + b x + c x^2`}
+
+var _TrendsMap = map[Trends]string{0: `NoTrend`, 1: `Linear`, 2: `Poly2`}
+
+// String returns the string representation of this Trends value.
+func (i Trends) String() string { return enums.String(i, _TrendsMap) }
+
+// SetString sets the Trends value from its string representation,
+// and returns an error if the string is invalid.
+func (i *Trends) SetString(s string) error { return enums.SetString(i, s, _TrendsValueMap, "Trends") }
+
+// Int64 returns the Trends value as an int64.
+func (i Trends) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the Trends value from an int64.
+func (i *Trends) SetInt64(in int64) { *i = Trends(in) }
+
+// Desc returns the description of the Trends value.
+func (i Trends) Desc() string { return enums.Desc(i, _TrendsDescMap) }
+
+// TrendsValues returns all possible values for the type Trends.
+func TrendsValues() []Trends { return _TrendsValues }
+
+// Values returns all possible values for the type Trends.
+func (i Trends) Values() []enums.Enum { return enums.Values(_TrendsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i Trends) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *Trends) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "Trends") }
@@ -22,6 +22,7 @@ import (
 	"cogentcore.org/core/math32"
 	"cogentcore.org/core/states"
 	"cogentcore.org/core/styles"
+	"cogentcore.org/core/svg"
 	"cogentcore.org/core/tree"
 	"cogentcore.org/core/views"
 	"github.com/emer/etable/v2/etable"
@@ -53,6 +54,20 @@ type Plot2D struct { //types:add
 	// of this function, as doing so will have no effect.
 	ConfigPlotFunc func() `json:"-" xml:"-"`
 
+	// SelectRowsFunc is called with the SelectedRows after a region of the
+	// plot is selected by dragging on it (when zoom and pan is off), e.g., to
+	// show the selected rows in another view.
+	SelectRowsFunc func(rows []int) `json:"-" xml:"-"`
+
+	// the source table row indexes of the points in the region of the plot
+	// most recently selected by dragging on it
+	SelectedRows []int `set:"-" edit:"-" json:"-" xml:"-"`
+
+	// show a readout in the toolbar of the data values under the pointer in an
+	// XY plot: the X and Y position, and the value of each On column at the
+	// nearest X value
+	Readout bool
+
 	// current svg file
 	SVGFile core.Filename
 
@@ -61,6 +76,30 @@ type Plot2D struct { //types:add
 
 	// currently doing a plot
 	InPlot bool `set:"-" edit:"-" json:"-" xml:"-"`
+
+	// the zoom scale of the plot view set by the user, which is restored
+	// after each plot update so that live-updating plots stay zoomed in
+	// -- 0 if the view has not been zoomed or panned (see ResetView)
+	ViewScale float32 `set:"-" edit:"-" json:"-" xml:"-"`
+
+	// the pan translation of the plot view set by the user, which is restored
+	// after each plot update along with ViewScale
+	ViewTranslate math32.Vector2 `set:"-" edit:"-" json:"-" xml:"-"`
+
+	// the default view scale and translation set by the last plot update,
+	// used to detect when the user has zoomed or panned
+	defScale     float32
+	defTranslate math32.Vector2
+
+	// the TableView opened by the Table toolbar button, which shows the
+	// SelectedRows
+	tableView *etview.TableView
+
+	// the toolbar label showing the Readout
+	readoutLabel *core.Label
+
+	// coalesces GoUpdatePlot calls according to Params.MinUpdateInterval
+	updateThrottle throttle
 }
 
 func (pl *Plot2D) CopyFieldsFrom(frm tree.Node) {
@@ -154,6 +193,69 @@ func (pl *Plot2D) SetColParams(colNm string, on bool, fixMin bool, min float64,
 	return cp
 }
 
+// SetColParamsFromTable sets main parameters for multiple columns from
+// given config table, which has one row per column to configure.
+// The Col string column (required) has the name of the column, and the
+// optional On, FixMin, Min, FixMax, Max, and Color columns set the
+// corresponding parameters, as in SetColParams.  Boolean values can be
+// numeric (non-zero = true) or strings (+ or true), and Color is a color
+// string (e.g., a name or hex value), which is ignored if empty.
+// Parameters without a config column are left unchanged.
+// Unknown column names are logged and skipped.
+func (pl *Plot2D) SetColParamsFromTable(cfg *etable.Table) {
+	nmc, err := cfg.ColByNameTry("Col")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for row := 0; row < cfg.Rows; row++ {
+		cp, err := pl.ColParamsTry(nmc.StringValue1D(row))
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if on, has := colParamsCfgBool(cfg, "On", row); has {
+			cp.On = on
+		}
+		if fix, has := colParamsCfgBool(cfg, "FixMin", row); has {
+			cp.Range.FixMin = fix
+		}
+		if cl := cfg.ColByName("Min"); cl != nil {
+			cp.Range.Min = cl.FloatValue1D(row)
+		}
+		if fix, has := colParamsCfgBool(cfg, "FixMax", row); has {
+			cp.Range.FixMax = fix
+		}
+		if cl := cfg.ColByName("Max"); cl != nil {
+			cp.Range.Max = cl.FloatValue1D(row)
+		}
+		if cl := cfg.ColByName("Color"); cl != nil {
+			if cs := cl.StringValue1D(row); cs != "" {
+				clr, err := colors.FromString(cs)
+				if err != nil {
+					log.Println(err)
+				} else {
+					cp.Color = clr
+				}
+			}
+		}
+	}
+}
+
+// colParamsCfgBool returns the bool value of given config table column at
+// given row, and false if the column does not exist.
+func colParamsCfgBool(cfg *etable.Table, colNm string, row int) (val, has bool) {
+	cl := cfg.ColByName(colNm)
+	if cl == nil {
+		return false, false
+	}
+	if cl.DataType() == etensor.STRING {
+		op := strings.ToLower(cl.StringValue1D(row))
+		return op == "+" || op == "true", true
+	}
+	return cl.FloatValue1D(row) != 0, true
+}
+
 // SaveSVG saves the plot to an svg -- first updates to ensure that plot is current
 func (pl *Plot2D) SaveSVG(fname core.Filename) { //types:add
 	pl.UpdatePlot()
@@ -201,35 +303,31 @@ func (pl *Plot2D) OpenFS(fsys fs.FS, filename core.Filename, delim etable.Delims
 
 // YLabel returns the Y-axis label
 func (pl *Plot2D) YLabel() string {
-	if pl.Params.YAxisLabel != "" {
-		return pl.Params.YAxisLabel
-	}
-	for _, cp := range pl.Cols {
-		if cp.On {
-			return cp.Label()
-		}
-	}
-	return "Y"
+	return pl.Params.YLabel(pl.Cols)
 }
 
 // XLabel returns the X-axis label
 func (pl *Plot2D) XLabel() string {
-	if pl.Params.XAxisLabel != "" {
-		return pl.Params.XAxisLabel
-	}
-	if pl.Params.XAxisCol != "" {
-		cp := pl.ColParams(pl.Params.XAxisCol)
-		if cp != nil {
-			return cp.Label()
-		}
-		return pl.Params.XAxisCol
-	}
-	return "X"
+	return pl.Params.XLabel(pl.Cols)
 }
 
 // GoUpdatePlot updates the display based on current IndexView into table.
 // this version can be called from go routines.
+// If Params.MinUpdateInterval is set, rapid calls are coalesced so that
+// the plot is updated at most once per interval, always with the latest data.
 func (pl *Plot2D) GoUpdatePlot() {
+	if pl == nil || pl.This() == nil {
+		return
+	}
+	if iv := pl.Params.MinUpdateInterval; iv > 0 {
+		pl.updateThrottle.call(iv, pl.goUpdatePlot)
+		return
+	}
+	pl.goUpdatePlot()
+}
+
+// goUpdatePlot does the update for GoUpdatePlot
+func (pl *Plot2D) goUpdatePlot() {
 	if pl == nil || pl.This() == nil {
 		return
 	}
@@ -289,7 +387,9 @@ func (pl *Plot2D) GenPlot() {
 		pl.GenPlotBar()
 	}
 	if pl.Plot != nil {
+		pl.saveView(sv.SVG)
 		PlotViewSVG(pl.Plot, sv, pl.Params.Scale)
+		pl.restoreView(sv.SVG)
 	} else {
 		sv.SVG.DeleteAll()
 		// slog.Error("eplot: no plot generated from gonum plot")
@@ -300,14 +400,34 @@ func (pl *Plot2D) GenPlot() {
 // PlotXAxis processes the XAxis and returns its index and any breaks to insert
 // based on negative X axis traversals or NaN values.  xbreaks always ends in last row.
 func (pl *Plot2D) PlotXAxis(plt *plot.Plot, ixvw *etable.IndexView) (xi int, xview *etable.IndexView, xbreaks []int, err error) {
-	xi, err = ixvw.Table.ColIndexTry(pl.Params.XAxisCol)
+	return PlotXAxis(plt, ixvw, &pl.Params, pl.Cols)
+}
+
+// PlotXAxis processes the XAxis for given plot params and column params
+// and returns its index and any breaks to insert based on negative X axis
+// traversals or NaN values.  xbreaks always ends in last row.
+// For a String X column, the X values are the category index of each
+// value (see XCategories).
+func PlotXAxis(plt *plot.Plot, ixvw *etable.IndexView, pp *PlotParams, cols []*ColParams) (xi int, xview *etable.IndexView, xbreaks []int, err error) {
+	return plotXAxis(plt, ixvw, pp, cols, nil)
+}
+
+// plotXAxis is [PlotXAxis] using the given category indexes for a String
+// X column, which are computed from ixvw if nil, so that the same indexes
+// can be used across different views, e.g., for each LegendCol value.
+func plotXAxis(plt *plot.Plot, ixvw *etable.IndexView, pp *PlotParams, cols []*ColParams, xcats map[string]int) (xi int, xview *etable.IndexView, xbreaks []int, err error) {
+	xi, err = ixvw.Table.ColIndexTry(pp.XAxisCol)
 	if err != nil {
 		log.Println("eplot.PlotXAxis: " + err.Error())
 		return
 	}
 	xview = ixvw
 	xc := ixvw.Table.Cols[xi]
-	xp := pl.Cols[xi]
+	xp := cols[xi]
+	isCat := xc.DataType() == etensor.STRING
+	if isCat && xcats == nil {
+		_, xcats = XCategories(ixvw, xi, pp.XAxisSort)
+	}
 	sz := 1
 	lim := false
 	if xp.Range.FixMin {
@@ -346,24 +466,42 @@ func (pl *Plot2D) PlotXAxis(plt *plot.Plot, ixvw *etable.IndexView) (xi int, xvi
 			return true
 		})
 	}
-	if pl.Params.NegXDraw {
-		xbreaks = append(xbreaks, xview.Len())
-		return
+	xval := func(row int) float64 {
+		if isCat {
+			if ci, ok := xcats[xc.StringValue1D(row)]; ok {
+				return float64(ci)
+			}
+			return math.NaN()
+		}
+		if xc.NumDims() > 1 {
+			return xc.FloatValueRowCell(row, xp.TensorIndex)
+		}
+		return xc.FloatValue1D(row)
 	}
+	if pp.XAxisSort {
+		if xview == ixvw {
+			xview = ixvw.Clone()
+		}
+		xview.SortStable(func(et *etable.Table, i, j int) bool {
+			return xval(i) < xval(j)
+		})
+	}
+	nonMono := false
 	lastx := -math.MaxFloat64
 	for row := 0; row < xview.Len(); row++ {
-		trow := xview.Indexes[row] // true table row
-		var xv float64
-		if xc.NumDims() > 1 {
-			xv = xc.FloatValueRowCell(trow, xp.TensorIndex)
-		} else {
-			xv = xc.FloatValue1D(trow)
-		}
+		xv := xval(xview.Indexes[row]) // true table row
 		if xv < lastx {
-			xbreaks = append(xbreaks, row)
+			nonMono = true
+			if !pp.NegXDraw {
+				xbreaks = append(xbreaks, row)
+			}
 		}
 		lastx = xv
 	}
+	if nonMono && !pp.NegXDraw && pp.nonMonoCol != pp.XAxisCol {
+		pp.nonMonoCol = pp.XAxisCol
+		slog.Info("eplot.PlotXAxis: X axis values are not monotonically increasing -- set XAxisSort to sort rows by X before plotting", "XAxisCol", pp.XAxisCol)
+	}
 	xbreaks = append(xbreaks, xview.Len())
 	return
 }
@@ -389,6 +527,17 @@ func (pl *Plot2D) ConfigPlot() {
 		pt.Style(func(s *styles.Style) {
 			s.Grow.Set(1, 1)
 		})
+		pt.On(events.SlideStop, func(e events.Event) {
+			if !pt.IsReadOnly() { // zoom and pan mode
+				return
+			}
+			pl.SelectRegion(e.StartPos(), e.Pos())
+		})
+		pt.On(events.MouseMove, func(e events.Event) {
+			if pl.Readout {
+				pl.UpdateReadout(e.Pos())
+			}
+		})
 
 	}
 
@@ -427,7 +576,15 @@ func (pl *Plot2D) ColsListUpdate() {
 	if nc == len(pl.Cols) {
 		return
 	}
-	pl.Cols = make([]*ColParams, nc)
+	pl.Cols = NewColsParams(dt, &pl.Params)
+}
+
+// NewColsParams returns a new list of column params for each column
+// of the given table, with defaults and colors set, and any settings
+// from the table meta data applied.  This is suitable for use
+// in the GenPlotXY and GenPlotBar functions.
+func NewColsParams(dt *etable.Table, pp *PlotParams) []*ColParams {
+	cols := make([]*ColParams, dt.NumCols())
 	clri := 0
 	for ci := range dt.Cols {
 		cn := dt.ColNames[ci]
@@ -439,15 +596,16 @@ func (pl *Plot2D) ColsListUpdate() {
 		} else {
 			cp.IsString = false
 		}
-		cp.FromMetaMap(pl.Table.Table.MetaData)
+		cp.FromMetaMap(dt.MetaData)
 		inc := 1
-		if cn == pl.Params.XAxisCol || tcol.DataType() == etensor.INT || tcol.DataType() == etensor.INT64 || tcol.DataType() == etensor.STRING {
+		if cn == pp.XAxisCol || tcol.DataType() == etensor.INT || tcol.DataType() == etensor.INT64 || tcol.DataType() == etensor.STRING {
 			inc = 0
 		}
 		cp.Color = colors.Spaced(clri)
-		pl.Cols[ci] = cp
+		cols[ci] = cp
 		clri += inc
 	}
+	return cols
 }
 
 // ColsFromMetaMap updates all the column settings from given meta map
@@ -520,6 +678,44 @@ func (pl *Plot2D) SetColsByName(nameContains string, on bool) { //types:add
 	pl.NeedsRender()
 }
 
+// SetXAxisCol sets the column to use for the X axis and updates the plot
+func (pl *Plot2D) SetXAxisCol(colNm string) {
+	pl.Params.XAxisCol = colNm
+	pl.UpdatePlot()
+}
+
+// CycleXAxisCol sets the X axis to the next (delta > 0) or previous
+// (delta < 0) non-string column after the current XAxisCol, wrapping
+// around, and updates the plot.  Returns the new XAxisCol.
+func (pl *Plot2D) CycleXAxisCol(delta int) string {
+	n := len(pl.Cols)
+	if n == 0 || delta == 0 {
+		return pl.Params.XAxisCol
+	}
+	cur := -1
+	for i, cp := range pl.Cols {
+		if cp.Col == pl.Params.XAxisCol {
+			cur = i
+			break
+		}
+	}
+	if cur < 0 && delta < 0 {
+		cur = 0
+	}
+	dir := 1
+	if delta < 0 {
+		dir = -1
+	}
+	for k := 1; k <= n; k++ {
+		cp := pl.Cols[((cur+dir*k)%n+n)%n]
+		if !cp.IsString {
+			pl.SetXAxisCol(cp.Col)
+			break
+		}
+	}
+	return pl.Params.XAxisCol
+}
+
 // ColsConfig configures the column gui buttons
 func (pl *Plot2D) ColsConfig() {
 	vl := pl.ColsLay()
@@ -562,8 +758,7 @@ func (pl *Plot2D) ColsConfig() {
 		bt := core.NewButton(cl, "col").SetText(cp.Col).SetType(core.ButtonAction)
 		bt.SetMenu(func(m *core.Scene) {
 			core.NewButton(m, "set-x").SetText("Set X Axis").OnClick(func(e events.Event) {
-				pl.Params.XAxisCol = cp.Col
-				pl.UpdatePlot()
+				pl.SetXAxisCol(cp.Col)
 			})
 			core.NewButton(m, "set-legend").SetText("Set Legend").OnClick(func(e events.Event) {
 				pl.Params.LegendCol = cp.Col
@@ -581,6 +776,49 @@ func (pl *Plot2D) ColsConfig() {
 	}
 }
 
+// saveView saves the current zoom and pan of given svg in ViewScale and
+// ViewTranslate if the user has changed them from the defaults set by the
+// last plot update.
+func (pl *Plot2D) saveView(sv *svg.SVG) {
+	if pl.defScale == 0 {
+		return
+	}
+	if sv.Scale != pl.defScale || sv.Translate != pl.defTranslate {
+		pl.ViewScale = sv.Scale
+		pl.ViewTranslate = sv.Translate
+	}
+}
+
+// restoreView records the default zoom and pan of given svg just set by a
+// plot update, and then restores any saved ViewScale and ViewTranslate.
+func (pl *Plot2D) restoreView(sv *svg.SVG) {
+	pl.defScale = sv.Scale
+	pl.defTranslate = sv.Translate
+	if pl.ViewScale != 0 {
+		sv.Scale = pl.ViewScale
+		sv.Translate = pl.ViewTranslate
+	}
+}
+
+// resetView clears any saved zoom and pan and restores the default view
+// of given svg.
+func (pl *Plot2D) resetView(sv *svg.SVG) {
+	pl.ViewScale = 0
+	pl.ViewTranslate = math32.Vector2{}
+	if pl.defScale != 0 {
+		sv.Scale = pl.defScale
+		sv.Translate = pl.defTranslate
+	}
+}
+
+// ResetView resets any zoom and pan of the plot view to the default,
+// which is otherwise preserved across plot updates.
+func (pl *Plot2D) ResetView() { //types:add
+	sv := pl.SVGPlot()
+	pl.resetView(sv.SVG)
+	sv.NeedsRender()
+}
+
 // PlotConfig configures the PlotView
 func (pl *Plot2D) PlotConfig() {
 	sv := pl.SVGPlot()
@@ -594,17 +832,57 @@ func (pl *Plot2D) ConfigToolbar(tb *core.Toolbar) {
 		return
 	}
 	core.NewButton(tb).SetIcon(icons.PanTool).
-		SetTooltip("toggle the ability to zoom and pan the view").OnClick(func(e events.Event) {
+		SetTooltip("toggle the ability to zoom and pan the view -- when off, dragging on the plot selects the rows of the points in a region").OnClick(func(e events.Event) {
 		sv := pl.SVGPlot()
 		sv.SetReadOnly(!sv.IsReadOnly())
 		sv.ApplyStyleUpdate()
 	})
+	core.NewButton(tb).SetIcon(icons.ZoomOutMap).
+		SetTooltip("reset any zoom and pan of the view, which is otherwise kept across updates").
+		OnClick(func(e events.Event) {
+			pl.ResetView()
+		})
 	core.NewButton(tb).SetIcon(icons.ArrowForward).
 		SetTooltip("turn on select mode for selecting SVG elements").
 		OnClick(func(e events.Event) {
 			fmt.Println("this will select select mode")
 		})
+	core.NewButton(tb).SetIcon(icons.Info).
+		SetTooltip("toggle a readout of the data values under the pointer").
+		OnClick(func(e events.Event) {
+			pl.Readout = !pl.Readout
+			if !pl.Readout && pl.readoutLabel != nil {
+				pl.readoutLabel.SetText("").Config()
+				pl.readoutLabel.NeedsLayout()
+			}
+		})
 	core.NewSeparator(tb)
+	core.NewButton(tb).SetText("X Axis").SetIcon(icons.SwapHoriz).
+		SetTooltip("select the column to use for the X axis").
+		SetMenu(func(m *core.Scene) {
+			core.NewButton(m).SetText("Next").SetIcon(icons.KeyboardArrowRight).
+				OnClick(func(e events.Event) {
+					pl.CycleXAxisCol(1)
+				})
+			core.NewButton(m).SetText("Previous").SetIcon(icons.KeyboardArrowLeft).
+				OnClick(func(e events.Event) {
+					pl.CycleXAxisCol(-1)
+				})
+			core.NewSeparator(m)
+			for _, cp := range pl.Cols {
+				if cp.IsString {
+					continue
+				}
+				cp := cp
+				bt := core.NewButton(m).SetText(cp.Col)
+				bt.OnClick(func(e events.Event) {
+					pl.SetXAxisCol(cp.Col)
+				})
+				if cp.Col == pl.Params.XAxisCol {
+					bt.SetIcon(icons.Check)
+				}
+			}
+		})
 	core.NewButton(tb).SetText("Update").SetIcon(icons.Update).
 		SetTooltip("update fully redraws display, reflecting any new settings etc").
 		OnClick(func(e events.Event) {
@@ -627,6 +905,10 @@ func (pl *Plot2D) ConfigToolbar(tb *core.Toolbar) {
 			d := core.NewBody().AddTitle(pl.Nm + " Data")
 			etv := etview.NewTableView(d).SetTable(pl.Table.Table)
 			d.AddAppBar(etv.ConfigToolbar)
+			pl.tableView = etv
+			if len(pl.SelectedRows) > 0 {
+				etv.SelectRows(pl.SelectedRows)
+			}
 			d.NewFullDialog(pl).SetNewWindow(true).Run()
 		})
 	core.NewSeparator(tb)
@@ -635,13 +917,17 @@ func (pl *Plot2D) ConfigToolbar(tb *core.Toolbar) {
 		views.NewFuncButton(m, pl.SaveSVG).SetIcon(icons.Save)
 		views.NewFuncButton(m, pl.SavePNG).SetIcon(icons.Save)
 		views.NewFuncButton(m, pl.SaveCSV).SetIcon(icons.Save)
+		views.NewFuncButton(m, pl.SaveConfig).SetIcon(icons.Save)
 		core.NewSeparator(m)
 		views.NewFuncButton(m, pl.SaveAll).SetIcon(icons.Save)
 	})
 	views.NewFuncButton(tb, pl.OpenCSV).SetIcon(icons.Open)
+	views.NewFuncButton(tb, pl.OpenConfig).SetIcon(icons.Open)
 	core.NewSeparator(tb)
 	views.NewFuncButton(tb, pl.Table.FilterColName).SetText("Filter").SetIcon(icons.FilterAlt)
 	views.NewFuncButton(tb, pl.Table.Sequential).SetText("Unfilter").SetIcon(icons.FilterAltOff)
+	core.NewSeparator(tb)
+	pl.readoutLabel = core.NewLabel(tb, "readout")
 }
 
 // NewSubPlot returns a Plot2D with its own separate Toolbar,
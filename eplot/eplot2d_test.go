@@ -0,0 +1,165 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"image/color"
+	"path/filepath"
+	"testing"
+
+	"cogentcore.org/core/colors"
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/math32"
+	"cogentcore.org/core/svg"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestSetColParamsFromTable(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+		{"Corr", etensor.FLOAT64, nil, nil},
+	}, 5)
+	pp := &PlotParams{XAxisCol: "Epoch"}
+	pp.Defaults()
+	pl := &Plot2D{Cols: NewColsParams(dt, pp)}
+
+	cfg := etable.New(etable.Schema{
+		{"Col", etensor.STRING, nil, nil},
+		{"On", etensor.BOOL, nil, nil},
+		{"FixMin", etensor.STRING, nil, nil},
+		{"Min", etensor.FLOAT64, nil, nil},
+		{"Color", etensor.STRING, nil, nil},
+	}, 3)
+	cfg.SetCellString("Col", 0, "Err")
+	cfg.SetCellFloat("On", 0, 1)
+	cfg.SetCellString("FixMin", 0, "+")
+	cfg.SetCellFloat("Min", 0, -1)
+	cfg.SetCellString("Color", 0, "red")
+	cfg.SetCellString("Col", 1, "Nope") // logged and skipped
+	cfg.SetCellString("Col", 2, "Corr")
+	cfg.SetCellFloat("On", 2, 0)
+	cfg.SetCellString("FixMin", 2, "false")
+
+	pl.ColParams("Corr").On = true
+	pl.SetColParamsFromTable(cfg)
+	ep := pl.ColParams("Err")
+	if !ep.On || !ep.Range.FixMin || ep.Range.Min != -1 {
+		t.Errorf("Err params: on %v fixMin %v min %v", ep.On, ep.Range.FixMin, ep.Range.Min)
+	}
+	if color.RGBAModel.Convert(ep.Color) != color.RGBAModel.Convert(colors.Red) {
+		t.Errorf("Err color: %v != red", ep.Color)
+	}
+	cp := pl.ColParams("Corr")
+	if cp.On || cp.Range.FixMin {
+		t.Errorf("Corr params: on %v fixMin %v", cp.On, cp.Range.FixMin)
+	}
+	if cp.Color == nil {
+		t.Error("Corr color should be unchanged by empty Color")
+	}
+}
+
+func TestPlotConfig(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 5)
+	pp := PlotParams{Title: "Learning", XAxisCol: "Epoch", TargetMin: .1, TargetMax: .2, TargetColor: color.RGBA{0, 128, 0, 64}}
+	pp.Defaults()
+	pl := &Plot2D{Params: pp, Cols: NewColsParams(dt, &pp)}
+	ep := pl.ColParams("Err")
+	ep.On = true
+	ep.Range.SetMax(1)
+	ep.Lines.Set(false)
+	ep.Color = color.RGBA{255, 0, 0, 255}
+
+	fname := core.Filename(filepath.Join(t.TempDir(), "plot.json"))
+	if err := pl.SaveConfig(fname); err != nil {
+		t.Fatal(err)
+	}
+
+	npp := PlotParams{}
+	npp.Defaults()
+	nt := dt.Clone()
+	nt.DeleteColName("Epoch") // only Err in common
+	npl := &Plot2D{Params: npp, Cols: NewColsParams(nt, &npp)}
+	if err := npl.OpenConfig(fname); err != nil {
+		t.Fatal(err)
+	}
+	if npl.Params.Title != "Learning" || npl.Params.TargetMax != .2 || npl.Params.Plot != npl {
+		t.Errorf("OpenConfig Params: %+v", npl.Params)
+	}
+	if color.RGBAModel.Convert(npl.Params.TargetColor) != color.RGBAModel.Convert(pp.TargetColor) {
+		t.Errorf("OpenConfig TargetColor: %v != %v", npl.Params.TargetColor, pp.TargetColor)
+	}
+	nep := npl.ColParams("Err")
+	if !nep.On || !nep.Range.FixMax || nep.Range.Max != 1 || nep.Lines.Or(true) || nep.Plot != npl {
+		t.Errorf("OpenConfig Err params: %+v", nep)
+	}
+	if color.RGBAModel.Convert(nep.Color) != color.RGBAModel.Convert(ep.Color) {
+		t.Errorf("OpenConfig Err color: %v != %v", nep.Color, ep.Color)
+	}
+}
+
+func TestPlotViewPersist(t *testing.T) {
+	pl := &Plot2D{}
+	sv := svg.NewSVG(100, 100)
+	update := func() { // simulates the view reset done by PlotViewSVG in UpdatePlot
+		pl.saveView(sv)
+		sv.Translate.Set(5, 5)
+		sv.Scale = .9
+		pl.restoreView(sv)
+	}
+	update()
+	if sv.Scale != .9 || pl.ViewScale != 0 {
+		t.Fatalf("default view: scale %v view scale %v", sv.Scale, pl.ViewScale)
+	}
+
+	// user zooms and pans
+	sv.Scale = 2
+	sv.Translate.Set(-30, 40)
+	update()
+	if sv.Scale != 2 || sv.Translate != (math32.Vector2{X: -30, Y: 40}) {
+		t.Errorf("zoom not restored after update: scale %v translate %v", sv.Scale, sv.Translate)
+	}
+	update()
+	if sv.Scale != 2 {
+		t.Errorf("zoom not restored after second update: scale %v", sv.Scale)
+	}
+
+	pl.resetView(sv)
+	if sv.Scale != .9 || sv.Translate != (math32.Vector2{X: 5, Y: 5}) || pl.ViewScale != 0 {
+		t.Errorf("resetView: scale %v translate %v", sv.Scale, sv.Translate)
+	}
+	update()
+	if sv.Scale != .9 {
+		t.Errorf("view should stay reset after update: scale %v", sv.Scale)
+	}
+}
+
+func TestCycleXAxisCol(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 2)
+	pp := &PlotParams{XAxisCol: "Epoch"}
+	pp.Defaults()
+	pl := &Plot2D{Params: *pp, Cols: NewColsParams(dt, pp)}
+	if x := pl.CycleXAxisCol(1); x != "Err" {
+		t.Errorf("CycleXAxisCol(1): %s != Err", x)
+	}
+	if x := pl.CycleXAxisCol(1); x != "Epoch" {
+		t.Errorf("CycleXAxisCol(1) should wrap and skip string column: %s != Epoch", x)
+	}
+	if x := pl.CycleXAxisCol(-1); x != "Err" {
+		t.Errorf("CycleXAxisCol(-1): %s != Err", x)
+	}
+	pl.SetXAxisCol("Epoch")
+	if pl.Params.XAxisCol != "Epoch" {
+		t.Errorf("SetXAxisCol: %s != Epoch", pl.Params.XAxisCol)
+	}
+}
@@ -23,8 +23,9 @@ import (
 
 // A ErrBarChart presents ordinally-organized data with rectangular bars
 // with lengths proportional to the data values, and an optional
-// error bar ("handle") at the top of the bar using given error value
-// (single value, like a standard deviation etc, not drawn below the bar).
+// error bar ("handle") at the end of the bar using given error value
+// (single value, like a standard deviation etc, not drawn within the bar,
+// and extending downward for negative values).  NaN errors are not drawn.
 //
 // Bars are plotted centered at integer multiples of Stride plus Start offset.
 // Full data range also includes Pad value to extend range beyond edge bar centers.
@@ -78,17 +79,18 @@ type ErrBarChart struct {
 // NewErrBarChart returns a new bar chart with a single bar for each value.
 // The bars heights correspond to the values and their x locations correspond
 // to the index of their value in the Valuer.  Optional error-bar values can be
-// provided.
+// provided: NaN errors, e.g., the Sem of a single value, are allowed and
+// no error bar is drawn for them.
 func NewErrBarChart(vs, ers plotter.Valuer) (*ErrBarChart, error) {
 	values, err := plotter.CopyValues(vs)
 	if err != nil {
 		return nil, err
 	}
 	var errs plotter.Values
-	if ers != nil {
-		errs, err = plotter.CopyValues(ers)
-		if err != nil {
-			return nil, err
+	if ers != nil { // not CopyValues, which rejects NaN
+		errs = make(plotter.Values, ers.Len())
+		for i := range errs {
+			errs[i] = ers.Value(i)
 		}
 	}
 	b := &ErrBarChart{
@@ -191,9 +193,8 @@ func (b *ErrBarChart) Plot(c draw.Canvas, plt *plot.Plot) {
 		}
 		c.StrokeLines(b.LineStyle, outline...)
 
-		if i < len(b.Errors) {
-			errval := b.Errors[i]
-			eVal := trValue(bottom + ht + math.Abs(errval))
+		if i < len(b.Errors) && !math.IsNaN(b.Errors[i]) {
+			eVal := trValue(bottom + ht + b.errorExtent(i))
 			if !b.Horizontal {
 				bar := c.ClipLinesY([]vg.Point{{catVal, valMax}, {catVal, eVal}})
 				c.StrokeLines(b.LineStyle, bar...)
@@ -207,6 +208,17 @@ func (b *ErrBarChart) Plot(c draw.Canvas, plt *plot.Plot) {
 	}
 }
 
+// errorExtent returns the signed extent of the error bar for the ith bar,
+// which extends beyond the end of the bar in the direction of its value,
+// i.e., downward for negative values.
+func (b *ErrBarChart) errorExtent(i int) float64 {
+	ev := math.Abs(b.Errors[i])
+	if b.Values[i] < 0 {
+		return -ev
+	}
+	return ev
+}
+
 // DataRange implements the plot.DataRanger interface.
 func (b *ErrBarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 	catMin := b.Start - b.Pad
@@ -217,8 +229,8 @@ func (b *ErrBarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 	for i, val := range b.Values {
 		valBot := b.stackedOn.BarHeight(i)
 		valTop := valBot + val
-		if i < len(b.Errors) {
-			valTop += math.Abs(b.Errors[i])
+		if i < len(b.Errors) && !math.IsNaN(b.Errors[i]) {
+			valTop += b.errorExtent(i)
 		}
 		valMin = math.Min(valMin, math.Min(valBot, valTop))
 		valMax = math.Max(valMax, math.Max(valBot, valTop))
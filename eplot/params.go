@@ -7,9 +7,11 @@ package eplot
 import (
 	"image/color"
 	"strings"
+	"time"
 
 	"cogentcore.org/core/gox/option"
 	"cogentcore.org/core/reflectx"
+	"cogentcore.org/core/views"
 	"github.com/emer/etable/v2/etable"
 	"github.com/emer/etable/v2/minmax"
 )
@@ -41,19 +43,34 @@ type PlotParams struct { //types:add
 	// width of bars for bar plot, as fraction of available space (1 = no gaps)
 	BarWidth float64 `min:"0.01" max:"1" default:"0.8"`
 
+	// draw the value of each bar as a text label at the end of the bar in a Bar plot (beyond any error bar), formatted using YTickFormat
+	BarValueLabels bool
+
 	// draw lines that connect points with a negative X-axis direction -- otherwise these are treated as breaks between repeated series and not drawn
 	NegXDraw bool
 
+	// sort the rows by the XAxisCol values before plotting, so that lines are drawn in order of increasing X -- otherwise non-monotonic X values are reported, and result in breaks in the lines (or zig-zag lines if NegXDraw is set)
+	XAxisSort bool
+
 	// overall scaling factor -- the larger the number, the larger the fonts are relative to the graph
 	Scale float64 `default:"2"`
 
-	// what column to use for the common X axis -- if empty or not found, the row number is used.  This optional for Bar plots -- if present and LegendCol is also present, then an extra space will be put between X values.
+	// what column to use for the common X axis -- if empty or not found, the row number is used.  This optional for Bar plots -- if present and LegendCol is also present, then an extra space will be put between X values.  For Bar plots, a String column provides the category label for each bar.
 	XAxisCol string
 
 	// optional column for adding a separate colored / styled line or bar according to this value -- acts just like a separate Y variable, crossed with Y variables
 	LegendCol string
 
-	// rotation of the X Axis labels, in degrees
+	// plot the mean and standard error of the mean (as error bars) of the rows with the same X value (and AggGroupCol value if set), instead of the individual rows -- e.g., to show the mean across runs instead of each individual run.  LegendCol is not used.
+	Aggregate bool
+
+	// optional column whose values define separate aggregated series when Aggregate is on, e.g., a condition column -- plotted as the legend
+	AggGroupCol string
+
+	// optional column whose values define separate groups of rows, e.g., runs, that are each plotted in an XY plot as a faint individual trace, with the mean across the groups at each X value plotted as a bold line on top -- a "spaghetti plot with mean".  Not used with Aggregate.
+	TracesCol string
+
+	// rotation of the X Axis labels, in degrees -- if 0, long category labels in a Bar plot with a String XAxisCol are rotated to avoid overlap
 	XAxisRot float64
 
 	// optional label to use for XAxis instead of column name
@@ -62,8 +79,45 @@ type PlotParams struct { //types:add
 	// optional label to use for YAxis -- if empty, first column name is used
 	YAxisLabel string
 
+	// fraction of the data range to add as padding at each end of the X axis when its range is automatic (not fixed), e.g., 0.05, so that points at the edges are not drawn on the frame
+	XRangePad float64 `min:"0" step:"0.01"`
+
+	// fraction of the data range to add as padding at each end of the Y axis when its range is automatic (not fixed), e.g., 0.05, so that points at the edges are not drawn on the frame
+	YRangePad float64 `min:"0" step:"0.01"`
+
+	// optional fmt-style format string for the X axis tick labels, e.g., "%.2f" -- if empty, default formatting is used
+	XTickFormat string
+
+	// optional fmt-style format string for the Y axis tick labels, e.g., "%.2f" -- if empty, default formatting is used
+	YTickFormat string
+
+	// approximate number of major ticks on the X axis, at nice round values -- if 0, the default ticks are used
+	XNTicks int `min:"0"`
+
+	// approximate number of major ticks on the Y axis, at nice round values -- if 0, the default ticks are used
+	YNTicks int `min:"0"`
+
+	// maximum number of points to plot per line in an XY plot -- if > 0, longer series are downsampled using min / max bucketing, which preserves spikes, for faster rendering.  The table data is not affected.
+	MaxPoints int
+
+	// lower Y value of an optional target region, drawn as a translucent horizontal band behind the data, e.g., an acceptable error range.  Only drawn if TargetMax > TargetMin.
+	TargetMin float64
+
+	// upper Y value of an optional target region, drawn as a translucent horizontal band behind the data.  Only drawn if TargetMax > TargetMin.
+	TargetMax float64
+
+	// color of the target region band, which should be translucent -- if nil, a translucent primary color is used
+	TargetColor color.Color
+
+	// minimum interval between plot updates by GoUpdatePlot, e.g., 100ms, so that calling it on every trial of a fast training run does not overwhelm rendering -- rapid calls are coalesced into one update at the end of the interval, showing the latest data.  0 = update on every call.
+	MinUpdateInterval time.Duration
+
 	// our plot, for update method
 	Plot *Plot2D `copier:"-" json:"-" xml:"-" view:"-"`
+
+	// XAxisCol for which non-monotonic X values have been reported,
+	// so they are only reported once, not on every render
+	nonMonoCol string
 }
 
 // Defaults sets defaults if nil vals present
@@ -137,9 +191,19 @@ func (pp *PlotParams) FromMetaMap(meta map[string]string) {
 	if ps, has := MetaMapLower(meta, "PointSize"); has {
 		pp.PointSize, _ = reflectx.ToFloat(ps)
 	}
+	if ps, has := MetaMapLower(meta, "PointShape"); has {
+		pp.PointShape.SetString(ps)
+	}
 	if bw, has := MetaMapLower(meta, "BarWidth"); has {
 		pp.BarWidth, _ = reflectx.ToFloat(bw)
 	}
+	if op, has := MetaMapLower(meta, "BarValueLabels"); has {
+		if op == "+" || op == "true" {
+			pp.BarValueLabels = true
+		} else {
+			pp.BarValueLabels = false
+		}
+	}
 	if op, has := MetaMapLower(meta, "NegXDraw"); has {
 		if op == "+" || op == "true" {
 			pp.NegXDraw = true
@@ -147,6 +211,13 @@ func (pp *PlotParams) FromMetaMap(meta map[string]string) {
 			pp.NegXDraw = false
 		}
 	}
+	if op, has := MetaMapLower(meta, "XAxisSort"); has {
+		if op == "+" || op == "true" {
+			pp.XAxisSort = true
+		} else {
+			pp.XAxisSort = false
+		}
+	}
 	if scl, has := MetaMapLower(meta, "Scale"); has {
 		pp.Scale, _ = reflectx.ToFloat(scl)
 	}
@@ -156,6 +227,19 @@ func (pp *PlotParams) FromMetaMap(meta map[string]string) {
 	if lc, has := MetaMapLower(meta, "LegendCol"); has {
 		pp.LegendCol = lc
 	}
+	if op, has := MetaMapLower(meta, "Aggregate"); has {
+		if op == "+" || op == "true" {
+			pp.Aggregate = true
+		} else {
+			pp.Aggregate = false
+		}
+	}
+	if gc, has := MetaMapLower(meta, "AggGroupCol"); has {
+		pp.AggGroupCol = gc
+	}
+	if tc, has := MetaMapLower(meta, "TracesCol"); has {
+		pp.TracesCol = tc
+	}
 	if xrot, has := MetaMapLower(meta, "XAxisRot"); has {
 		pp.XAxisRot, _ = reflectx.ToFloat(xrot)
 	}
@@ -165,6 +249,70 @@ func (pp *PlotParams) FromMetaMap(meta map[string]string) {
 	if lb, has := MetaMapLower(meta, "YAxisLabel"); has {
 		pp.YAxisLabel = lb
 	}
+	if pd, has := MetaMapLower(meta, "XRangePad"); has {
+		pp.XRangePad, _ = reflectx.ToFloat(pd)
+	}
+	if pd, has := MetaMapLower(meta, "YRangePad"); has {
+		pp.YRangePad, _ = reflectx.ToFloat(pd)
+	}
+	if tf, has := MetaMapLower(meta, "XTickFormat"); has {
+		pp.XTickFormat = tf
+	}
+	if tf, has := MetaMapLower(meta, "YTickFormat"); has {
+		pp.YTickFormat = tf
+	}
+	if nt, has := MetaMapLower(meta, "XNTicks"); has {
+		iv, _ := reflectx.ToInt(nt)
+		pp.XNTicks = int(iv)
+	}
+	if nt, has := MetaMapLower(meta, "YNTicks"); has {
+		iv, _ := reflectx.ToInt(nt)
+		pp.YNTicks = int(iv)
+	}
+	if mp, has := MetaMapLower(meta, "MaxPoints"); has {
+		iv, _ := reflectx.ToInt(mp)
+		pp.MaxPoints = int(iv)
+	}
+	if iv, has := MetaMapLower(meta, "MinUpdateInterval"); has {
+		pp.MinUpdateInterval, _ = time.ParseDuration(iv)
+	}
+	if tm, has := MetaMapLower(meta, "TargetMin"); has {
+		pp.TargetMin, _ = reflectx.ToFloat(tm)
+	}
+	if tm, has := MetaMapLower(meta, "TargetMax"); has {
+		pp.TargetMax, _ = reflectx.ToFloat(tm)
+	}
+}
+
+// YLabel returns the Y-axis label, using the first On column
+// in given column params if YAxisLabel is not set.
+func (pp *PlotParams) YLabel(cols []*ColParams) string {
+	if pp.YAxisLabel != "" {
+		return pp.YAxisLabel
+	}
+	for _, cp := range cols {
+		if cp.On {
+			return cp.Label()
+		}
+	}
+	return "Y"
+}
+
+// XLabel returns the X-axis label, using the XAxisCol column params
+// label from given column params if XAxisLabel is not set.
+func (pp *PlotParams) XLabel(cols []*ColParams) string {
+	if pp.XAxisLabel != "" {
+		return pp.XAxisLabel
+	}
+	if pp.XAxisCol != "" {
+		for _, cp := range cols {
+			if cp.Col == pp.XAxisCol {
+				return cp.Label()
+			}
+		}
+		return pp.XAxisCol
+	}
+	return "X"
 }
 
 // ColParams are parameters for plotting one column of data
@@ -212,6 +360,18 @@ type ColParams struct { //types:add
 	// specifies a column containing error bars for this column
 	ErrCol string
 
+	// optional least-squares fit line to overlay on each series of this column in an XY plot, drawn dashed in the series color, with the fit equation in the legend
+	Trend Trends
+
+	// optional column whose values set the size of each point, for a bubble chart -- sizes are scaled from 0.5 to 3 times the PointSize over the range of values, with the point area proportional to the value
+	SizeCol string
+
+	// optional column whose values set the color of each point, using ColorMap over the range of values, with a color bar added to the legend
+	ColorValCol string
+
+	// the name of the color map to use for ColorValCol (ColdHot if empty)
+	ColorMap views.ColorMapName
+
 	// if true this is a string column -- plots as labels
 	IsString bool `edit:"-"`
 
@@ -303,6 +463,38 @@ func (cp *ColParams) FromMetaMap(meta map[string]string) {
 	if lb, has := MetaMapLower(meta, cp.Col+":ErrCol"); has {
 		cp.ErrCol = lb
 	}
+	if tr, has := MetaMapLower(meta, cp.Col+":Trend"); has {
+		cp.Trend.SetString(tr)
+	}
+	if lb, has := MetaMapLower(meta, cp.Col+":SizeCol"); has {
+		cp.SizeCol = lb
+	}
+	if lb, has := MetaMapLower(meta, cp.Col+":ColorValCol"); has {
+		cp.ColorValCol = lb
+	}
+	if lb, has := MetaMapLower(meta, cp.Col+":ColorMap"); has {
+		cp.ColorMap = views.ColorMapName(lb)
+	}
+	if op, has := MetaMapLower(meta, cp.Col+":Lines"); has {
+		cp.Lines.Set(op == "+" || op == "true")
+	}
+	if op, has := MetaMapLower(meta, cp.Col+":Points"); has {
+		cp.Points.Set(op == "+" || op == "true")
+	}
+	if vl, has := MetaMapLower(meta, cp.Col+":LineWidth"); has {
+		lw, _ := reflectx.ToFloat(vl)
+		cp.LineWidth.Set(lw)
+	}
+	if vl, has := MetaMapLower(meta, cp.Col+":PointSize"); has {
+		ps, _ := reflectx.ToFloat(vl)
+		cp.PointSize.Set(ps)
+	}
+	if vl, has := MetaMapLower(meta, cp.Col+":PointShape"); has {
+		var sh Shapes
+		if err := sh.SetString(vl); err == nil {
+			cp.PointShape.Set(sh)
+		}
+	}
 	if vl, has := MetaMapLower(meta, cp.Col+":TensorIndex"); has {
 		iv, _ := reflectx.ToInt(vl)
 		cp.TensorIndex = int(iv)
@@ -0,0 +1,156 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"cogentcore.org/core/colors/colormap"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/minmax"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// PointStyler sets the size and / or color of each point in a scatter plot
+// from the values of other columns in the table, according to the
+// SizeCol and ColorValCol of ColParams, for a bubble chart.
+// Its GlyphStyle method is used as the plotter.Scatter GlyphStyleFunc.
+type PointStyler struct {
+
+	// the XY view of the points being plotted
+	XY *TableXY
+
+	// the base glyph style for all points
+	Base draw.GlyphStyle
+
+	// the column for point sizes -- nil if not used
+	SizeCol etensor.Tensor
+
+	// the range of SizeCol values, across the full table view
+	SizeRange minmax.F64
+
+	// the column for point colors -- nil if not used
+	ColorCol etensor.Tensor
+
+	// the range of ColorCol values, across the full table view
+	ColorRange minmax.F64
+
+	// the color map used for ColorCol values
+	ColorMap *colormap.Map
+}
+
+// NewPointStyler returns a new PointStyler for given XY view of points,
+// using the SizeCol and ColorValCol of given column params, with value
+// ranges computed over the given full view of the table, so that they are
+// consistent across all series.  Returns nil if neither column is set,
+// and an error if a column is not found.
+func NewPointStyler(ix *etable.IndexView, xy *TableXY, cp *ColParams, base draw.GlyphStyle) (*PointStyler, error) {
+	if cp.SizeCol == "" && cp.ColorValCol == "" {
+		return nil, nil
+	}
+	ps := &PointStyler{XY: xy, Base: base}
+	if cp.SizeCol != "" {
+		si, err := ix.Table.ColIndexTry(cp.SizeCol)
+		if err != nil {
+			return nil, err
+		}
+		ps.SizeCol = ix.Table.Cols[si]
+		ps.SizeRange.Min, ps.SizeRange.Max = ix.ColRange(si, 0)
+	}
+	if cp.ColorValCol != "" {
+		ci, err := ix.Table.ColIndexTry(cp.ColorValCol)
+		if err != nil {
+			return nil, err
+		}
+		ps.ColorCol = ix.Table.Cols[ci]
+		ps.ColorRange.Min, ps.ColorRange.Max = ix.ColRange(ci, 0)
+		cmn := string(cp.ColorMap)
+		if cmn == "" {
+			cmn = "ColdHot"
+		}
+		cm, ok := colormap.AvailableMaps[cmn]
+		if !ok {
+			return nil, fmt.Errorf("eplot.NewPointStyler: colormap %q not found", cmn)
+		}
+		ps.ColorMap = cm
+	}
+	return ps, nil
+}
+
+// rowCellValue returns the value of given column at given true table row,
+// using the first cell of n-dimensional columns.
+func rowCellValue(col etensor.Tensor, row int) float64 {
+	if col.NumDims() > 1 {
+		return col.FloatValueRowCell(row, 0)
+	}
+	return col.FloatValue1D(row)
+}
+
+// normValue returns v normalized to the 0-1 range of given rng,
+// or .5 if the range is empty.
+func normValue(rng minmax.F64, v float64) float64 {
+	if rng.Range() <= 0 {
+		return .5
+	}
+	return rng.ClipNormValue(v)
+}
+
+// GlyphStyle returns the glyph style for the point at given index
+// in the XY view, satisfying the plotter.Scatter GlyphStyleFunc.
+func (ps *PointStyler) GlyphStyle(i int) draw.GlyphStyle {
+	gs := ps.Base
+	if i < 0 || i >= ps.XY.Len() {
+		return gs
+	}
+	row := ps.XY.Table.Indexes[i] // true table row
+	if ps.SizeCol != nil {
+		v := rowCellValue(ps.SizeCol, row)
+		if !math.IsNaN(v) {
+			gs.Radius = vg.Length(float64(ps.Base.Radius) * (.5 + 2.5*math.Sqrt(normValue(ps.SizeRange, v))))
+		}
+	}
+	if ps.ColorCol != nil {
+		v := rowCellValue(ps.ColorCol, row)
+		if !math.IsNaN(v) {
+			gs.Color = ps.ColorMap.Map(float32(normValue(ps.ColorRange, v)))
+		}
+	}
+	return gs
+}
+
+// AddColorBar adds a color bar for the ColorCol values to the legend of
+// given plot, as a set of color swatches labeled with the corresponding
+// values, from the top (max) to the bottom (min) of the range.
+func (ps *PointStyler) AddColorBar(plt *plot.Plot, lbl string, n int) {
+	if ps.ColorCol == nil || !ps.ColorRange.IsValid() {
+		return
+	}
+	if n < 2 {
+		n = 2
+	}
+	for i := n - 1; i >= 0; i-- {
+		nv := float64(i) / float64(n-1)
+		v := ps.ColorRange.Min + nv*ps.ColorRange.Range()
+		plt.Legend.Add(fmt.Sprintf("%s %.3g", lbl, v), colorSwatch{ps.ColorMap.Map(float32(nv))})
+	}
+}
+
+// colorSwatch is a legend thumbnail filled with a solid color
+type colorSwatch struct {
+	Color color.Color
+}
+
+// Thumbnail satisfies the plot.Thumbnailer interface
+func (cs colorSwatch) Thumbnail(c *draw.Canvas) {
+	c.FillPolygon(cs.Color, c.ClipPolygonY([]vg.Point{
+		{X: c.Min.X, Y: c.Min.Y}, {X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y}, {X: c.Max.X, Y: c.Min.Y},
+	}))
+}
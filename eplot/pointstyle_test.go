@@ -0,0 +1,75 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/minmax"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+func TestPointStyler(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+		{"Size", etensor.FLOAT64, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("Y", ri, float64(ri%2))
+		dt.SetCellFloat("Size", ri, float64(ri))
+		dt.SetCellFloat("Val", ri, float64(dt.Rows-ri))
+	}
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	pp.Lines = false
+	pp.Points = true
+	cols := NewColsParams(dt, pp)
+	cp := cols[1]
+	cp.On = true
+	cp.SizeCol = "Size"
+	cp.ColorValCol = "Val"
+	ix := etable.NewIndexView(dt)
+
+	xy, err := NewTableXYName(ix, 0, 0, "Y", 0, minmax.Range64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := draw.GlyphStyle{Radius: vg.Points(3)}
+	ps, err := NewPointStyler(ix, xy, cp, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g0 := ps.GlyphStyle(0)
+	g4 := ps.GlyphStyle(4)
+	if g0.Radius >= g4.Radius {
+		t.Errorf("SizeCol: radius should increase with size: %v >= %v", g0.Radius, g4.Radius)
+	}
+	if g4.Radius != 6*g0.Radius {
+		t.Errorf("SizeCol: max radius %v != 6 * min radius %v", g4.Radius, g0.Radius)
+	}
+	if reflect.DeepEqual(g0.Color, g4.Color) {
+		t.Error("ColorValCol: colors should differ at min and max values")
+	}
+
+	plt, err := GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nleg := len(legendEntries(plt)); nleg != 1+5 {
+		t.Errorf("ColorValCol: legend entries: %d != 6 (series + color bar)", nleg)
+	}
+
+	cp.ColorValCol = "NotThere"
+	if _, err := NewPointStyler(ix, xy, cp, base); err == nil {
+		t.Error("NewPointStyler: expected error for missing column")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+)
+
+// PadRanges expands the automatic (not fixed) ends of the X and Y axis
+// ranges of given plot by the XRangePad and YRangePad fractions of the
+// data range, so that points at the edges are not drawn on the frame.
+// It must be called after all the data has been added to the plot.
+// The X axis range is fixed if the X column (at index xi) has a fixed
+// Range, and is not padded if xi < 0, e.g., for a Bar plot.
+// The Y axis range is fixed if any On column has a fixed Range.
+func (pp *PlotParams) PadRanges(plt *plot.Plot, cols []*ColParams, xi int) {
+	if xi >= 0 && xi < len(cols) {
+		xp := cols[xi]
+		padAxis(&plt.X, pp.XRangePad, xp.Range.FixMin, xp.Range.FixMax)
+	}
+	yfixMin, yfixMax := false, false
+	for ci, cp := range cols {
+		if !cp.On || cp.IsString || ci == xi {
+			continue
+		}
+		yfixMin = yfixMin || cp.Range.FixMin
+		yfixMax = yfixMax || cp.Range.FixMax
+	}
+	padAxis(&plt.Y, pp.YRangePad, yfixMin, yfixMax)
+}
+
+// padAxis expands the non-fixed ends of the given axis range by
+// given fraction of the range.
+func padAxis(ax *plot.Axis, pad float64, fixMin, fixMax bool) {
+	rng := ax.Max - ax.Min
+	if pad <= 0 || rng <= 0 || math.IsInf(rng, 0) || math.IsNaN(rng) {
+		return
+	}
+	if !fixMin {
+		ax.Min -= pad * rng
+	}
+	if !fixMax {
+		ax.Max += pad * rng
+	}
+}
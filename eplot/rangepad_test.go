@@ -0,0 +1,60 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestPadRanges(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 11)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("Y", ri, float64(10-ri))
+	}
+	pp := &PlotParams{XAxisCol: "X", XRangePad: .1, YRangePad: .1}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1.0e-9 }
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(plt.X.Min, -1) || !near(plt.X.Max, 11) {
+		t.Errorf("PadRanges X: [%g, %g] != [-1, 11]", plt.X.Min, plt.X.Max)
+	}
+	if !near(plt.Y.Min, -1) || !near(plt.Y.Max, 11) {
+		t.Errorf("PadRanges Y: [%g, %g] != [-1, 11]", plt.Y.Min, plt.Y.Max)
+	}
+
+	// fixed ends are not padded
+	cols[1].Range.SetMin(0)
+	plt, err = GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(plt.Y.Min, 0) || !near(plt.Y.Max, 11) {
+		t.Errorf("PadRanges fixed Y min: [%g, %g] != [0, 11]", plt.Y.Min, plt.Y.Max)
+	}
+
+	// no padding by default
+	pp.XRangePad, pp.YRangePad = 0, 0
+	plt, err = GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(plt.X.Min, 0) || !near(plt.X.Max, 10) {
+		t.Errorf("PadRanges default X: [%g, %g] != [0, 10]", plt.X.Min, plt.X.Max)
+	}
+}
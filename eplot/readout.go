@@ -0,0 +1,121 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+
+	"github.com/emer/etable/v2/etable"
+)
+
+// NearestPoint is the data point of one column that is nearest
+// to a given X value, as returned by NearestPoints.
+type NearestPoint struct {
+
+	// name of the column in the plotted table, e.g., with a :Mean suffix
+	// for an Aggregate or TracesCol plot
+	Col string
+
+	// row index of the point in the plotted table, which is the source
+	// table unless Aggregate or TracesCol is set
+	Row int
+
+	// X axis value of the point, as plotted
+	X float64
+
+	// column value of the point
+	Y float64
+}
+
+// NearestPoints returns, for each On column plotted in an XY plot of given
+// IndexView, the point whose plotted X value is nearest to the given x
+// value, skipping rows with NaN values.  The points are from the same
+// table and X values that GenPlotXY plots: the aggregated means if
+// Aggregate or TracesCol is set, and category indexes for a String
+// XAxisCol (see XCategories).  For columns with tensor cells, the
+// TensorIndex value is used, or the first value if it is -1.
+// Columns without any valid points are not included.  Returns nil if
+// XAxisCol is not found.
+func NearestPoints(ix *etable.IndexView, pp *PlotParams, cols []*ColParams, x float64) []NearestPoint {
+	if ix == nil || ix.Table == nil || len(cols) != ix.Table.NumCols() {
+		return nil
+	}
+	if _, err := ix.Table.ColIndexTry(pp.XAxisCol); err != nil {
+		return nil
+	}
+	ix, pp, cols, err := xyPlotTable(ix, pp, cols)
+	if err != nil {
+		return nil
+	}
+	dt := ix.Table
+	xi, err := dt.ColIndexTry(pp.XAxisCol)
+	if err != nil {
+		return nil
+	}
+	xy := plotXValues(ix, pp, cols, xi)
+	var pts []NearestPoint
+	for ci, cp := range cols {
+		if !cp.On || cp.IsString || ci == xi {
+			continue
+		}
+		yc := dt.Cols[ci]
+		np := NearestPoint{Col: cp.Col, Row: -1}
+		mind := math.Inf(1)
+		for _, row := range ix.Indexes {
+			var yv float64
+			xv := xy.TRowXValue(row)
+			if yc.NumDims() > 1 {
+				yv = yc.FloatValueRowCell(row, max(cp.TensorIndex, 0))
+			} else {
+				yv = yc.FloatValue1D(row)
+			}
+			if math.IsNaN(xv) || math.IsNaN(yv) {
+				continue
+			}
+			if d := math.Abs(xv - x); d < mind {
+				mind = d
+				np.Row, np.X, np.Y = row, xv, yv
+			}
+		}
+		if np.Row >= 0 {
+			pts = append(pts, np)
+		}
+	}
+	return pts
+}
+
+// ReadoutText returns the text for a readout of the given pointer
+// position in data coordinates, and nearest points of each column.
+func ReadoutText(x, y float64, pts []NearestPoint) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "X: %.4g  Y: %.4g", x, y)
+	for _, np := range pts {
+		fmt.Fprintf(&b, "  %s: %.4g", np.Col, np.Y)
+	}
+	return b.String()
+}
+
+// UpdateReadout updates the readout of the data values at the given
+// position within the scene, e.g., of a mouse move event, shown in the
+// toolbar when Readout is on, and returns the readout text.
+// Only XY plots have a readout.
+func (pl *Plot2D) UpdateReadout(pos image.Point) string {
+	if pl.Params.Type != XY {
+		return ""
+	}
+	x, y, ok := pl.PlotPoint(pos)
+	if !ok {
+		return ""
+	}
+	txt := ReadoutText(x, y, NearestPoints(pl.Table, &pl.Params, pl.Cols, x))
+	if pl.readoutLabel != nil && pl.readoutLabel.This() != nil {
+		pl.readoutLabel.SetText(txt).Config()
+		pl.readoutLabel.NeedsLayout()
+	}
+	return txt
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+func TestNearestPoints(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+		{"B", etensor.FLOAT64, nil, nil},
+	}, 10)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("A", ri, float64(ri*ri))
+		dt.SetCellFloat("B", ri, float64(-ri))
+	}
+	dt.SetCellFloat("B", 3, math.NaN())
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	cols[2].On = true
+	ix := etable.NewIndexView(dt)
+
+	pts := NearestPoints(ix, pp, cols, 3.3)
+	if len(pts) != 2 {
+		t.Fatalf("NearestPoints: %v", pts)
+	}
+	if pts[0].Col != "A" || pts[0].Row != 3 || pts[0].Y != 9 {
+		t.Errorf("NearestPoints A: %+v", pts[0])
+	}
+	if pts[1].Col != "B" || pts[1].Row != 4 || pts[1].Y != -4 { // row 3 is NaN
+		t.Errorf("NearestPoints B: %+v", pts[1])
+	}
+	if txt := ReadoutText(3.3, 9, pts); !strings.Contains(txt, "A: 9") {
+		t.Errorf("ReadoutText: %q", txt)
+	}
+
+	// map a pointer position on the canvas to the nearest data index
+	plt, err := GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, h := 4*vg.Inch, 3*vg.Inch
+	da := plt.DataCanvas(draw.Canvas{Rectangle: vg.Rectangle{Max: vg.Point{X: w, Y: h}}})
+	trX, trY := plt.Transforms(&da)
+	x, _ := DataPoint(plt, w, h, vg.Point{X: trX(6.9), Y: trY(40)})
+	pts = NearestPoints(ix, pp, cols, x)
+	if len(pts) == 0 || pts[0].Row != 7 {
+		t.Errorf("NearestPoints from pointer x: %v: %v", x, pts)
+	}
+}
+
+func TestNearestPointsPlotted(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for ri, v := range []float64{1, 3, 5, 7} {
+		dt.SetCellFloat("X", ri, float64(ri/2))
+		dt.SetCellFloat("A", ri, v)
+	}
+	pp := &PlotParams{XAxisCol: "X", Aggregate: true}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	ix := etable.NewIndexView(dt)
+
+	pts := NearestPoints(ix, pp, cols, 0.9)
+	if len(pts) != 1 || pts[0].Col != "A:Mean" || pts[0].X != 1 || pts[0].Y != 6 {
+		t.Errorf("NearestPoints Aggregate: %+v", pts)
+	}
+
+	st := etable.New(etable.Schema{
+		{"X", etensor.STRING, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+	}, 3)
+	for ri, x := range []string{"c", "a", "b"} {
+		st.SetCellString("X", ri, x)
+		st.SetCellFloat("A", ri, float64(ri))
+	}
+	pp = &PlotParams{XAxisCol: "X", XAxisSort: true}
+	pp.Defaults()
+	cols = NewColsParams(st, pp)
+	cols[1].On = true
+	pts = NearestPoints(etable.NewIndexView(st), pp, cols, 1.8) // sorted categories a, b, c
+	if len(pts) != 1 || pts[0].Row != 0 || pts[0].X != 2 {
+		t.Errorf("NearestPoints String X: %+v", pts)
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"image"
+	"math"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/split"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// RowsInRange returns the source table row indexes of the rows of given
+// IndexView, in view order, that are plotted within [xmin, xmax] on the
+// X axis and have a value of any On column within [ymin, ymax], i.e., the
+// rows plotted within that region of the plot in data coordinates.
+// X values are mapped as in the plot: for an XY plot, a String XAxisCol
+// uses its category index (see XCategories), and for a Bar plot, each bar
+// is at its position as in GenPlotBar.  For an Aggregate plot, the
+// individual rows of the aggregated groups within the region are returned.
+// Returns nil if XAxisCol is not found.
+func RowsInRange(ix *etable.IndexView, pp *PlotParams, cols []*ColParams, xmin, xmax, ymin, ymax float64) []int {
+	if ix == nil || ix.Table == nil {
+		return nil
+	}
+	dt := ix.Table
+	xi, err := dt.ColIndexTry(pp.XAxisCol)
+	if err != nil || len(cols) != dt.NumCols() {
+		return nil
+	}
+	if pp.Type == Bar {
+		return barRowsInRange(ix, pp, cols, xmin, xmax, ymin, ymax)
+	}
+	xy := plotXValues(ix, pp, cols, xi)
+	var rows []int
+	for _, row := range ix.Indexes {
+		xv := xy.TRowXValue(row)
+		if math.IsNaN(xv) || xv < xmin || xv > xmax {
+			continue
+		}
+		hit := false
+		for ci, cp := range cols {
+			if !cp.On || cp.IsString || ci == xi {
+				continue
+			}
+			yc := dt.Cols[ci]
+			switch {
+			case yc.NumDims() == 1:
+				hit = inRange(yc.FloatValue1D(row), ymin, ymax)
+			case cp.TensorIndex >= 0:
+				hit = inRange(yc.FloatValueRowCell(row, cp.TensorIndex), ymin, ymax)
+			default:
+				_, sz := yc.RowCellSize()
+				for i := 0; i < sz && !hit; i++ {
+					hit = inRange(yc.FloatValueRowCell(row, i), ymin, ymax)
+				}
+			}
+			if hit {
+				break
+			}
+		}
+		if hit {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// inRange returns whether v is within [min, max], and not NaN
+func inRange(v, min, max float64) bool {
+	return !math.IsNaN(v) && v >= min && v <= max
+}
+
+// barRowsInRange is RowsInRange for a Bar plot
+func barRowsInRange(ix *etable.IndexView, pp *PlotParams, cols []*ColParams, xmin, xmax, ymin, ymax float64) []int {
+	bix, bpp, bcols := ix, pp, cols
+	if pp.Aggregate {
+		aix, app, acols, err := AggregateTable(ix, pp, cols)
+		if err != nil {
+			return nil
+		}
+		bix, bpp, bcols = aix, app, acols
+	}
+	hits := map[int]bool{}
+	barPositions(bix, bpp, bcols, func(row int, x, y float64) {
+		if x >= xmin && x <= xmax && inRange(y, ymin, ymax) {
+			hits[row] = true
+		}
+	})
+	if pp.Aggregate { // rows of the groups of the aggregated rows
+		gcols := []string{pp.XAxisCol}
+		if pp.AggGroupCol != "" {
+			gcols = append(gcols, pp.AggGroupCol)
+		}
+		groups := map[string]bool{}
+		for row := range hits {
+			groups[groupKey(bix.Table, gcols, row)] = true
+		}
+		hits = map[int]bool{}
+		for _, row := range ix.Indexes {
+			if groups[groupKey(ix.Table, gcols, row)] {
+				hits[row] = true
+			}
+		}
+	}
+	var rows []int
+	for _, row := range ix.Indexes {
+		if hits[row] {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// groupKey returns the key for the group of given row for given columns,
+// from their string values, as used in split.GroupBy
+func groupKey(dt *etable.Table, gcols []string, row int) string {
+	key := ""
+	for _, gc := range gcols {
+		key += dt.ColByName(gc).StringValue1D(row) + "\t"
+	}
+	return key
+}
+
+// barPositions calls fn with the source table row, X position, and value
+// of each bar plotted for the given view in a Bar plot, positioned as in
+// GenPlotBar.
+func barPositions(ix *etable.IndexView, pp *PlotParams, cols []*ColParams, fn func(row int, x, y float64)) {
+	dt := ix.Table
+	xi, xview, _, err := PlotXAxis(plot.New(), ix, pp, cols)
+	if err != nil {
+		return
+	}
+	xp := cols[xi]
+	var lsplit *etable.Splits
+	nleg := 1
+	if _, err := dt.ColIndexTry(pp.LegendCol); pp.LegendCol != "" && err == nil {
+		xview = xview.Clone()
+		xview.SortColNames([]string{pp.LegendCol, xp.Col}, etable.Ascending)
+		lsplit = split.GroupBy(xview, []string{pp.LegendCol})
+		nleg = max(lsplit.Len(), 1)
+	}
+	nys := 0
+	for _, cp := range cols {
+		if !cp.On || cp.IsString {
+			continue
+		}
+		if cp.TensorIndex < 0 {
+			_, sz := dt.ColByName(cp.Col).RowCellSize()
+			nys += sz
+		} else {
+			nys++
+		}
+	}
+	stride := nys * nleg
+	if stride > 1 {
+		stride += 1 // extra gap
+	}
+	yoff := 0
+	for _, cp := range cols {
+		if !cp.On || cp == xp || cp.IsString {
+			continue
+		}
+		start := yoff
+		for li := 0; li < nleg; li++ {
+			lview := xview
+			if lsplit != nil && len(lsplit.Values) > li {
+				lview = lsplit.Splits[li]
+			}
+			nidx := 1
+			stidx := cp.TensorIndex
+			if cp.TensorIndex < 0 {
+				_, nidx = dt.ColByName(cp.Col).RowCellSize()
+				stidx = 0
+			}
+			for ii := 0; ii < nidx; ii++ {
+				xy, _ := NewTableXYName(lview, xi, xp.TensorIndex, cp.Col, stidx+ii, cp.Range)
+				if xy == nil {
+					continue
+				}
+				for i := 0; i < xy.Len(); i++ {
+					fn(xy.Table.Indexes[i], float64(start+i*stride), xy.Value(i))
+				}
+				start++
+			}
+		}
+		yoff += nleg
+	}
+}
+
+// DataPoint returns the data coordinates of the given point on a canvas
+// of size w x h that the given plot is drawn onto, where the point is
+// relative to the bottom-left corner of the canvas, as in gonum plot.
+// It is the inverse of the plot data transforms, assuming linear axes.
+func DataPoint(plt *plot.Plot, w, h vg.Length, pt vg.Point) (x, y float64) {
+	c := draw.Canvas{Rectangle: vg.Rectangle{Max: vg.Point{X: w, Y: h}}}
+	da := plt.DataCanvas(c)
+	sz := da.Size()
+	x = plt.X.Min + float64((pt.X-da.Min.X)/sz.X)*(plt.X.Max-plt.X.Min)
+	y = plt.Y.Min + float64((pt.Y-da.Min.Y)/sz.Y)*(plt.Y.Max-plt.Y.Min)
+	return
+}
+
+// PlotPoint returns the data coordinates of the point in the plot at the
+// given position within the scene, e.g., of a mouse event, undoing the
+// layout and any zoom and pan of the plot view.  Returns false if there
+// is no current plot.
+func (pl *Plot2D) PlotPoint(pos image.Point) (x, y float64, ok bool) {
+	if pl.Plot == nil || pl.Params.Scale == 0 || !pl.HasChildren() {
+		return
+	}
+	sv := pl.SVGPlot()
+	bb := sv.Geom.ContentBBox
+	sz := bb.Size()
+	if sz.X < 10 || sz.Y < 10 {
+		return
+	}
+	scale := pl.Params.Scale
+	w := vg.Length(float64(sz.X) / scale)
+	h := vg.Length(float64(sz.Y) / scale)
+	vs := float64(sv.SVG.Scale)
+	if vs == 0 {
+		vs = 1
+	}
+	px := (float64(pos.X-bb.Min.X) - float64(sv.SVG.Translate.X)) / vs
+	py := (float64(pos.Y-bb.Min.Y) - float64(sv.SVG.Translate.Y)) / vs
+	x, y = DataPoint(pl.Plot, w, h, vg.Point{X: vg.Length(px / scale), Y: h - vg.Length(py/scale)})
+	return x, y, true
+}
+
+// SelectRegion selects the rows of the table plotted within the rectangle
+// between the given two positions within the scene, e.g., the start and
+// end of a mouse drag on the plot, using SelectRows.
+func (pl *Plot2D) SelectRegion(start, end image.Point) {
+	x0, y0, ok := pl.PlotPoint(start)
+	if !ok {
+		return
+	}
+	x1, y1, _ := pl.PlotPoint(end)
+	pl.SelectRows(RowsInRange(pl.Table, &pl.Params, pl.Cols, min(x0, x1), max(x0, x1), min(y0, y1), max(y0, y1)))
+}
+
+// SelectRows sets the SelectedRows to the given source table row indexes,
+// calls the SelectRowsFunc if set, and selects the rows in the TableView
+// opened by the Table toolbar button, if any.
+func (pl *Plot2D) SelectRows(rows []int) {
+	pl.SelectedRows = rows
+	if pl.SelectRowsFunc != nil {
+		pl.SelectRowsFunc(rows)
+	}
+	if pl.tableView != nil && pl.tableView.This() != nil {
+		pl.tableView.SelectRows(rows)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+func TestRowsInRange(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+		{"B", etensor.FLOAT64, nil, nil},
+	}, 10)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("A", ri, float64(ri))
+		dt.SetCellFloat("B", ri, float64(10*ri))
+	}
+	dt.SetCellFloat("A", 4, math.NaN())
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	ix := etable.NewIndexView(dt)
+
+	if rows := RowsInRange(ix, pp, cols, 2, 6, 0, 5); !slices.Equal(rows, []int{2, 3, 5}) {
+		t.Errorf("RowsInRange: %v != [2 3 5]", rows)
+	}
+	cols[2].On = true // B values in range also count
+	if rows := RowsInRange(ix, pp, cols, 0, 9, 35, 45); !slices.Equal(rows, []int{4}) {
+		t.Errorf("RowsInRange any column: %v != [4]", rows)
+	}
+	ix.Indexes = []int{5, 3, 8}
+	if rows := RowsInRange(ix, pp, cols, 0, 6, 0, 100); !slices.Equal(rows, []int{5, 3}) {
+		t.Errorf("RowsInRange view order: %v != [5 3]", rows)
+	}
+	pp.XAxisCol = "Missing"
+	if rows := RowsInRange(ix, pp, cols, 0, 9, 0, 100); rows != nil {
+		t.Errorf("RowsInRange missing X column: %v != nil", rows)
+	}
+}
+
+func TestRowsInRangeXMapping(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.STRING, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+		{"B", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for ri, x := range []string{"b", "a", "b", "c"} {
+		dt.SetCellString("X", ri, x)
+		dt.SetCellFloat("A", ri, float64(ri))
+		dt.SetCellFloat("B", ri, float64(10*ri))
+	}
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	ix := etable.NewIndexView(dt)
+
+	// XY: categories b = 0, a = 1, c = 2
+	if rows := RowsInRange(ix, pp, cols, 0.5, 1.5, 0, 100); !slices.Equal(rows, []int{1}) {
+		t.Errorf("RowsInRange String X: %v != [1]", rows)
+	}
+	pp.XAxisSort = true // a = 0, b = 1, c = 2
+	if rows := RowsInRange(ix, pp, cols, 0.5, 1.5, 0, 100); !slices.Equal(rows, []int{0, 2}) {
+		t.Errorf("RowsInRange sorted String X: %v != [0 2]", rows)
+	}
+	pp.XAxisSort = false
+
+	// Bar: one bar per row, at the row index
+	pp.Type = Bar
+	if rows := RowsInRange(ix, pp, cols, 1.5, 3.5, 0, 100); !slices.Equal(rows, []int{2, 3}) {
+		t.Errorf("RowsInRange Bar: %v != [2 3]", rows)
+	}
+	// two columns: stride of 3, with A bars at 0, 3, 6, 9 and B bars at 1, 4, 7, 10
+	cols[2].On = true
+	if rows := RowsInRange(ix, pp, cols, 3.5, 4.5, 0, 100); !slices.Equal(rows, []int{1}) {
+		t.Errorf("RowsInRange Bar stride: %v != [1]", rows)
+	}
+	if rows := RowsInRange(ix, pp, cols, 5.5, 7.5, 5, 100); !slices.Equal(rows, []int{2}) {
+		t.Errorf("RowsInRange Bar stride values: %v != [2]", rows)
+	}
+}
+
+func TestDataPoint(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 10)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("Y", ri, float64(ri*ri))
+	}
+	pp := &PlotParams{Title: "Test", XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, h := 4*vg.Inch, 3*vg.Inch
+	da := plt.DataCanvas(draw.Canvas{Rectangle: vg.Rectangle{Max: vg.Point{X: w, Y: h}}})
+	trX, trY := plt.Transforms(&da)
+	x, y := DataPoint(plt, w, h, vg.Point{X: trX(3), Y: trY(50)})
+	if math.Abs(x-3) > 1.0e-6 || math.Abs(y-50) > 1.0e-6 {
+		t.Errorf("DataPoint: (%g, %g) != (3, 50)", x, y)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+	"math"
+
+	"cogentcore.org/core/core"
+	"cogentcore.org/core/styles"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/split"
+)
+
+// SplitPlots creates a grid of small-multiple plots in given parent, one
+// for each distinct value of given group column in the given view of a
+// table (as in split.GroupBy), i.e., faceting.  Each plot is a NewSubPlot
+// with its own toolbar, plotting a copy of the rows in its group, with the
+// table meta data, using a copy of the given plot params, titled with the
+// group value.  The column params of each plot are initialized from the
+// table meta data, and can be further customized on the returned plots.
+// Returns an error if the group column is not found.
+func SplitPlots(par core.Widget, ix *etable.IndexView, groupCol string, pp *PlotParams) ([]*Plot2D, error) {
+	spl, err := split.GroupByTry(ix, []string{groupCol})
+	if err != nil {
+		return nil, fmt.Errorf("eplot.SplitPlots: %w", err)
+	}
+	ns := len(spl.Splits)
+	fr := core.NewFrame(par, "split-plots")
+	fr.Style(func(s *styles.Style) {
+		s.Display = styles.Grid
+		s.Columns = max(1, int(math.Ceil(math.Sqrt(float64(ns)))))
+		s.Grow.Set(1, 1)
+	})
+	pls := make([]*Plot2D, ns)
+	for si, sv := range spl.Splits {
+		gv := spl.Values[si][0]
+		gt := sv.NewTable()
+		gt.CopyMetaDataFrom(ix.Table)
+		pl := NewSubPlot(fr, "plot-"+gv)
+		if pp != nil {
+			pl.Params.CopyFrom(pp)
+		}
+		pl.Params.Title = groupCol + ": " + gv
+		pl.Table = etable.NewIndexView(gt)
+		pl.Cols = NewColsParams(gt, &pl.Params)
+		pl.UpdatePlot()
+		pls[si] = pl
+	}
+	return pls, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"testing"
+
+	"cogentcore.org/core/core"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestSplitPlots(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 7)
+	conds := []string{"A", "B", "C", "A", "B", "C", "A"}
+	for i, c := range conds {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("Epoch", i, float64(i))
+		dt.SetCellFloat("Err", i, 1/float64(i+1))
+	}
+	dt.SetMetaData("Err:On", "+")
+	pp := &PlotParams{XAxisCol: "Epoch"}
+	pp.Defaults()
+
+	b := core.NewBody()
+	pls, err := SplitPlots(b, etable.NewIndexView(dt), "Cond", pp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pls) != 3 {
+		t.Fatalf("SplitPlots: %d plots != 3", len(pls))
+	}
+	for i, pl := range pls {
+		cond := conds[i]
+		if pl.Params.Title != "Cond: "+cond || pl.Params.XAxisCol != "Epoch" {
+			t.Errorf("SplitPlots %d: title %q x %q", i, pl.Params.Title, pl.Params.XAxisCol)
+		}
+		gt := pl.Table.Table
+		for r := 0; r < gt.Rows; r++ {
+			if gt.CellString("Cond", r) != cond {
+				t.Errorf("SplitPlots %s: row %d has Cond %s", cond, r, gt.CellString("Cond", r))
+			}
+		}
+		if !pl.ColParams("Err").On {
+			t.Errorf("SplitPlots %s: Err column should be On from meta data", cond)
+		}
+	}
+	if pls[0].Table.Table.Rows != 3 {
+		t.Errorf("SplitPlots A: rows %d != 3", pls[0].Table.Table.Rows)
+	}
+	if _, err := SplitPlots(b, etable.NewIndexView(dt), "Nope", pp); err == nil {
+		t.Error("SplitPlots: expected error for missing group column")
+	}
+}
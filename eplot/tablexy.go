@@ -8,6 +8,7 @@ import (
 	"errors"
 	"log"
 	"math"
+	"slices"
 
 	"github.com/emer/etable/v2/etable"
 	"github.com/emer/etable/v2/etensor"
@@ -41,6 +42,11 @@ type TableXY struct {
 
 	// range constraints on Y values
 	YRange minmax.Range64
+
+	// for a String X column, the category index of each value, used as
+	// the X value for a categorical X axis (see XCategories) -- if nil,
+	// the row index is used
+	XCats map[string]int
 }
 
 // NewTableXY returns a new XY plot view onto the given IndexView of etable.Table (makes a copy),
@@ -177,7 +183,7 @@ func (txy *TableXY) TRowXValue(row int) float64 {
 	x := 0.0
 	switch {
 	case xc.DataType() == etensor.STRING:
-		x = float64(row)
+		x = txy.xCatValue(xc, row, row)
 	case xc.NumDims() > 1:
 		_, sz := xc.RowCellSize()
 		if txy.XIndex < sz && txy.XIndex >= 0 {
@@ -202,7 +208,7 @@ func (txy *TableXY) XValue(row int) float64 {
 	x := 0.0
 	switch {
 	case xc.DataType() == etensor.STRING:
-		x = float64(row)
+		x = txy.xCatValue(xc, trow, row)
 	case xc.NumDims() > 1:
 		_, sz := xc.RowCellSize()
 		if txy.XIndex < sz && txy.XIndex >= 0 {
@@ -217,6 +223,20 @@ func (txy *TableXY) XValue(row int) float64 {
 	return x
 }
 
+// xCatValue returns the x value for given String X column at given true
+// table row, which is the category index of its value if XCats is set,
+// and otherwise the given row index.
+func (txy *TableXY) xCatValue(xc etensor.Tensor, trow, row int) float64 {
+	if txy.XCats == nil {
+		return float64(row)
+	}
+	ci, ok := txy.XCats[xc.StringValue1D(trow)]
+	if !ok {
+		return math.NaN()
+	}
+	return float64(ci)
+}
+
 // XY returns an x, y pair at given row in table
 func (txy *TableXY) XY(row int) (x, y float64) {
 	if txy.Table == nil || txy.Table.Table == nil {
@@ -257,3 +277,48 @@ func (txy *TableXY) YError(row int) (float64, float64) {
 	}
 	return -eval, eval
 }
+
+// XCategories returns the unique values of the given String column across
+// the rows of given IndexView, in order of first occurrence, or sorted in
+// natural (numeric-aware) order if sorted is true, along with a map from
+// each value to its index in that order, for plotting the column as a
+// categorical X axis.
+func XCategories(ix *etable.IndexView, xcol int, sorted bool) ([]string, map[string]int) {
+	xc := ix.Table.Cols[xcol]
+	var cats []string
+	cmap := make(map[string]int)
+	for _, row := range ix.Indexes {
+		v := xc.StringValue1D(row)
+		if _, has := cmap[v]; !has {
+			cmap[v] = len(cats)
+			cats = append(cats, v)
+		}
+	}
+	if sorted {
+		slices.SortFunc(cats, func(a, b string) int {
+			switch {
+			case etensor.NaturalLess(a, b):
+				return -1
+			case etensor.NaturalLess(b, a):
+				return 1
+			}
+			return 0
+		})
+		for i, c := range cats {
+			cmap[c] = i
+		}
+	}
+	return cats, cmap
+}
+
+// plotXValues returns a TableXY on the given view whose TRowXValue is the
+// X value plotted for each table row in an XY plot, as in GenPlotXY: the
+// category index (see XCategories) for a String X column, and otherwise
+// the X column value, at its TensorIndex for n-dimensional cells.
+func plotXValues(ix *etable.IndexView, pp *PlotParams, cols []*ColParams, xi int) *TableXY {
+	xy := &TableXY{Table: ix, XCol: xi, XIndex: cols[xi].TensorIndex}
+	if ix.Table.Cols[xi].DataType() == etensor.STRING {
+		_, xy.XCats = XCategories(ix, xi, pp.XAxisSort)
+	}
+	return xy
+}
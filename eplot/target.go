@@ -0,0 +1,72 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"image/color"
+	"math"
+
+	"cogentcore.org/core/colors"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// TargetBand is a plotter that fills a horizontal band across the
+// full X range of the plot, between Min and Max Y values, e.g., to
+// show a target region of acceptable values behind the data.
+// It should be added to the plot before the data so it is drawn behind.
+type TargetBand struct {
+	// Min is the lower Y value of the band
+	Min float64
+
+	// Max is the upper Y value of the band
+	Max float64
+
+	// Color is the fill color of the band, which should be translucent
+	Color color.Color
+}
+
+// NewTargetBand returns a new TargetBand for given Y range and color.
+// If clr is nil, a translucent version of the primary color is used.
+func NewTargetBand(min, max float64, clr color.Color) *TargetBand {
+	if clr == nil {
+		clr = colors.WithAF32(colors.Scheme.Primary.Base, .2)
+	}
+	return &TargetBand{Min: min, Max: max, Color: clr}
+}
+
+// Plot implements the plot.Plotter interface
+func (tb *TargetBand) Plot(c draw.Canvas, plt *plot.Plot) {
+	_, trY := plt.Transforms(&c)
+	ymin := max(trY(tb.Min), c.Min.Y)
+	ymax := min(trY(tb.Max), c.Max.Y)
+	if ymax <= ymin {
+		return
+	}
+	var pth vg.Path
+	pth.Move(vg.Point{X: c.Min.X, Y: ymin})
+	pth.Line(vg.Point{X: c.Max.X, Y: ymin})
+	pth.Line(vg.Point{X: c.Max.X, Y: ymax})
+	pth.Line(vg.Point{X: c.Min.X, Y: ymax})
+	pth.Close()
+	c.SetColor(tb.Color)
+	c.Fill(pth)
+}
+
+// DataRange implements the plot.DataRanger interface, ensuring that the
+// band is within the Y range, without affecting the X range.
+func (tb *TargetBand) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return math.Inf(1), math.Inf(-1), tb.Min, tb.Max
+}
+
+// AddTarget adds a TargetBand to given plot if the target region
+// is set in the params (TargetMax > TargetMin).
+func (pp *PlotParams) AddTarget(plt *plot.Plot) {
+	if pp.TargetMax <= pp.TargetMin {
+		return
+	}
+	plt.Add(NewTargetBand(pp.TargetMin, pp.TargetMax, pp.TargetColor))
+}
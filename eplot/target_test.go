@@ -0,0 +1,103 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestTargetBand(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 10)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("Epoch", ri, float64(ri))
+		dt.SetCellFloat("Err", ri, 1/float64(ri+1))
+	}
+	pp := &PlotParams{XAxisCol: "Epoch"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acts, _ := drawPlot(plt)
+	nfill := numFills(acts)
+
+	clr := color.RGBA{0, 64, 0, 64}
+	pp.TargetMin = .2
+	pp.TargetMax = .4
+	pp.TargetColor = clr
+	plt, err = GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acts, dc := drawPlot(plt)
+	if nf := numFills(acts); nf != nfill+1 {
+		t.Errorf("TargetBand: number of fills: %d != %d, unset target should not add a band", nf, nfill+1)
+	}
+	_, trY := plt.Transforms(&dc)
+
+	var cur color.Color
+	found := false
+	lineDrawn := false
+	for _, a := range acts {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			cur = a.Color
+		case *recorder.Stroke:
+			if cur == cols[1].Color {
+				lineDrawn = true
+			}
+		case *recorder.Fill:
+			if cur != clr {
+				continue
+			}
+			found = true
+			if lineDrawn {
+				t.Error("TargetBand: should be drawn before the data")
+			}
+			xmin, xmax := vg.Length(math.Inf(1)), vg.Length(math.Inf(-1))
+			ymin, ymax := xmin, xmax
+			for _, pc := range a.Path {
+				if pc.Type == vg.CloseComp {
+					continue
+				}
+				xmin, xmax = min(xmin, pc.Pos.X), max(xmax, pc.Pos.X)
+				ymin, ymax = min(ymin, pc.Pos.Y), max(ymax, pc.Pos.Y)
+			}
+			if xmin != dc.Min.X || xmax != dc.Max.X {
+				t.Errorf("TargetBand: X extent %v..%v != data range %v..%v", xmin, xmax, dc.Min.X, dc.Max.X)
+			}
+			if math.Abs(float64(ymin-trY(.2))) > 1e-6 || math.Abs(float64(ymax-trY(.4))) > 1e-6 {
+				t.Errorf("TargetBand: Y extent %v..%v != %v..%v", ymin, ymax, trY(.2), trY(.4))
+			}
+		}
+	}
+	if !found {
+		t.Error("TargetBand: no filled rectangle drawn with target color")
+	}
+}
+
+// numFills returns the number of filled paths in given recorded actions
+func numFills(acts []recorder.Action) int {
+	n := 0
+	for _, a := range acts {
+		if _, ok := a.(*recorder.Fill); ok {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"sync"
+	"time"
+)
+
+// throttle coalesces rapid calls to a function, e.g., plot updates from
+// a training loop, so that it is called at most once per interval.
+type throttle struct {
+	mu sync.Mutex
+
+	// time of the last call of the function
+	last time.Time
+
+	// a call is scheduled at the end of the current interval
+	pending bool
+
+	// clock functions, time.Now and time.AfterFunc if nil (set in tests)
+	now       func() time.Time
+	afterFunc func(d time.Duration, f func())
+}
+
+// call calls fn now if at least given interval has elapsed since the last
+// call, and otherwise schedules a single call of fn at the end of the
+// interval, with which all the calls until then are coalesced, so that
+// the final call is never dropped.  fn must use the latest state when
+// it is called, not state captured when call was called.
+func (th *throttle) call(interval time.Duration, fn func()) {
+	th.mu.Lock()
+	if th.pending {
+		th.mu.Unlock()
+		return
+	}
+	now := th.clock()
+	wait := interval - now.Sub(th.last)
+	if wait <= 0 {
+		th.last = now
+		th.mu.Unlock()
+		fn()
+		return
+	}
+	th.pending = true
+	th.mu.Unlock()
+	th.after(wait, func() {
+		th.mu.Lock()
+		th.pending = false
+		th.last = th.clock()
+		th.mu.Unlock()
+		fn()
+	})
+}
+
+// clock returns the current time
+func (th *throttle) clock() time.Time {
+	if th.now != nil {
+		return th.now()
+	}
+	return time.Now()
+}
+
+// after calls f in its own goroutine after given duration
+func (th *throttle) after(d time.Duration, f func()) {
+	if th.afterFunc != nil {
+		th.afterFunc(d, f)
+		return
+	}
+	time.AfterFunc(d, f)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	cur := time.Unix(1000, 0)
+	var timers []func()
+	var waits []time.Duration
+	th := &throttle{
+		now: func() time.Time { return cur },
+		afterFunc: func(d time.Duration, f func()) {
+			waits = append(waits, d)
+			timers = append(timers, f)
+		},
+	}
+	data, shown, n := 0, 0, 0
+	update := func() {
+		shown = data
+		n++
+	}
+	iv := 50 * time.Millisecond
+	for i := 1; i <= 20; i++ {
+		data = i
+		th.call(iv, update)
+		cur = cur.Add(time.Millisecond)
+	}
+	if n != 1 || shown != 1 {
+		t.Errorf("throttle: first call: n: %d shown: %d != 1, 1", n, shown)
+	}
+	if len(timers) != 1 || waits[0] != iv-time.Millisecond {
+		t.Fatalf("throttle: scheduled calls: %d waits: %v != 1, [%v]", len(timers), waits, iv-time.Millisecond)
+	}
+
+	cur = cur.Add(30 * time.Millisecond)
+	timers[0]()
+	if n != 2 || shown != 20 {
+		t.Errorf("throttle: coalesced call: n: %d shown: %d != 2, 20", n, shown)
+	}
+
+	data = 21
+	cur = cur.Add(iv)
+	th.call(iv, update)
+	if n != 3 || shown != 21 || len(timers) != 1 {
+		t.Errorf("throttle: call after interval: n: %d shown: %d timers: %d != 3, 21, 1", n, shown, len(timers))
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/plot"
+)
+
+// Ticks is a plot.Ticker that generates approximately N major tick marks
+// at "nice" round values, with labels formatted using the fmt-style Format
+// string, e.g., "%.2f".  If N is 0, the gonum plot.DefaultTicks are used,
+// and if Format is empty, the default %g formatting is used.
+type Ticks struct {
+
+	// approximate number of major ticks -- uses the default ticks if 0
+	N int
+
+	// fmt-style format string for the tick labels, e.g., "%.2f" -- uses %g if empty
+	Format string
+}
+
+// Ticks returns the ticks for given range, satisfying the plot.Ticker interface.
+func (tk *Ticks) Ticks(lo, hi float64) []plot.Tick {
+	if tk.N <= 0 || hi <= lo || math.IsInf(hi-lo, 0) || math.IsNaN(hi-lo) {
+		ticks := plot.DefaultTicks{}.Ticks(lo, hi)
+		if tk.Format != "" {
+			for i := range ticks {
+				if !ticks[i].IsMinor() {
+					ticks[i].Label = fmt.Sprintf(tk.Format, ticks[i].Value)
+				}
+			}
+		}
+		return ticks
+	}
+	format := tk.Format
+	if format == "" {
+		format = "%g"
+	}
+	step := niceStep((hi - lo) / float64(max(tk.N-1, 1)))
+	var ticks []plot.Tick
+	for i := math.Ceil(lo / step); i*step <= hi+step*1e-9; i++ {
+		v := i * step
+		if math.Abs(v) < step*1e-9 {
+			v = 0 // avoid -0 and rounding error labels
+		}
+		ticks = append(ticks, plot.Tick{Value: v, Label: fmt.Sprintf(format, v)})
+	}
+	return ticks
+}
+
+// niceStep returns the smallest "nice" round step size of 1, 2, 2.5 or 5
+// times a power of 10 that is >= given raw step size.
+func niceStep(raw float64) float64 {
+	if raw <= 0 {
+		return 1
+	}
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 2.5, 5, 10} {
+		if m*mag >= raw*(1-1e-9) {
+			return m * mag
+		}
+	}
+	return 10 * mag
+}
+
+// ConfigTicks sets the tick markers of the X and Y axes of given plot
+// according to the XNTicks, XTickFormat, YNTicks and YTickFormat params,
+// if any are set.  The X axis is not changed if nominalX is true, i.e.,
+// it has category labels.
+func (pp *PlotParams) ConfigTicks(plt *plot.Plot, nominalX bool) {
+	if !nominalX && (pp.XNTicks > 0 || pp.XTickFormat != "") {
+		plt.X.Tick.Marker = &Ticks{N: pp.XNTicks, Format: pp.XTickFormat}
+	}
+	if pp.YNTicks > 0 || pp.YTickFormat != "" {
+		plt.Y.Tick.Marker = &Ticks{N: pp.YNTicks, Format: pp.YTickFormat}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestTicks(t *testing.T) {
+	tk := &Ticks{Format: "%.0f"}
+	for _, tc := range tk.Ticks(0, 7.3) {
+		if tc.IsMinor() {
+			continue
+		}
+		if _, err := strconv.Atoi(tc.Label); err != nil {
+			t.Errorf("Ticks %%.0f: label %q is not an integer", tc.Label)
+		}
+	}
+
+	tk = &Ticks{N: 3}
+	ticks := tk.Ticks(0, 1)
+	if n := len(ticks); n < 2 || n > 4 {
+		t.Errorf("Ticks N=3: got %d ticks: %v", n, ticks)
+	}
+	tk = &Ticks{N: 3, Format: "%.2f"}
+	ticks = tk.Ticks(0, 1)
+	if len(ticks) == 0 || ticks[len(ticks)-1].Label != "1.00" {
+		t.Errorf("Ticks N=3 %%.2f: %v", ticks)
+	}
+}
+
+func TestConfigTicks(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 11)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("Y", ri, float64(ri)*0.37)
+	}
+	pp := &PlotParams{XAxisCol: "X", YTickFormat: "%.0f", YNTicks: 3}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, ok := plt.Y.Tick.Marker.(*Ticks)
+	if !ok {
+		t.Fatalf("ConfigTicks: Y ticker not set: %T", plt.Y.Tick.Marker)
+	}
+	ticks := tk.Ticks(plt.Y.Min, plt.Y.Max)
+	if n := len(ticks); n < 2 || n > 4 {
+		t.Errorf("ConfigTicks: got %d Y ticks: %v", n, ticks)
+	}
+	if _, ok := plt.X.Tick.Marker.(*Ticks); ok {
+		t.Errorf("ConfigTicks: X ticker should not be set")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+
+	"cogentcore.org/core/colors"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/split"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// TracesOpacity is the opacity of the individual traces drawn for each
+// group of rows with the same PlotParams.TracesCol value.
+var TracesOpacity = float32(.25)
+
+// AddTraces adds a faint line to given plot for the rows of each group of
+// rows of given IndexView with the same PlotParams.TracesCol value (e.g.,
+// each run), in order of X value, for each numeric column that is On,
+// used to draw individual traces behind their mean.  The lines are not added
+// to the legend.  Returns the number of lines added.
+func AddTraces(plt *plot.Plot, ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (int, error) {
+	dt := ix.Table
+	xi, err := dt.ColIndexTry(pp.XAxisCol)
+	if err != nil {
+		return 0, fmt.Errorf("eplot.AddTraces: XAxisCol is required: %w", err)
+	}
+	xp := cols[xi]
+	spl, err := split.GroupByTry(ix, []string{pp.TracesCol})
+	if err != nil {
+		return 0, fmt.Errorf("eplot.AddTraces: TracesCol: %w", err)
+	}
+	n := 0
+	for _, sp := range spl.Splits {
+		sp.SortStableCol(xi, etable.Ascending)
+		for ci, cp := range cols {
+			if !cp.On || ci == xi || cp.IsString || cp.Col == pp.TracesCol {
+				continue
+			}
+			xy, err := NewTableXY(sp, xi, xp.TensorIndex, ci, max(cp.TensorIndex, 0), cp.Range)
+			if err != nil {
+				continue
+			}
+			lns, err := plotter.NewLine(xy)
+			if err != nil {
+				continue
+			}
+			lns.LineStyle.Width = vg.Points(cp.LineWidth.Or(pp.LineWidth))
+			lns.LineStyle.Color = colors.WithAF32(cp.Color, TracesOpacity)
+			plt.Add(lns)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// TracesMeanTable returns the mean across the groups of rows with the same
+// PlotParams.TracesCol value at each X value, as for [AggregateTable],
+// with copies of the plot and column params configured to plot the
+// mean of each On column as a bold line, without error bars.
+func TracesMeanTable(ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (*etable.IndexView, *PlotParams, []*ColParams, error) {
+	mpp := &PlotParams{}
+	*mpp = *pp
+	mpp.AggGroupCol = ""
+	mcols := make([]*ColParams, len(cols))
+	for ci, cp := range cols {
+		mcols[ci] = cp
+		if cp.Col == pp.TracesCol && cp.On { // not averaged
+			mcols[ci] = &ColParams{}
+			mcols[ci].CopyFrom(cp)
+			mcols[ci].On = false
+		}
+	}
+	aix, app, acols, err := AggregateTable(ix, mpp, mcols)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, acp := range acols {
+		acp.ErrCol = ""
+		if acp.On {
+			acp.LineWidth.Set(2 * acp.LineWidth.Or(pp.LineWidth))
+		}
+	}
+	return aix, app, acols, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestTraces(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 12)
+	nruns, neps := 3, 4
+	ri := 0
+	for ep := 0; ep < neps; ep++ { // rows not in run order
+		for run := 0; run < nruns; run++ {
+			dt.SetCellFloat("Run", ri, float64(run))
+			dt.SetCellFloat("Epoch", ri, float64(ep))
+			dt.SetCellFloat("Err", ri, float64(ep*run))
+			ri++
+		}
+	}
+	pp := &PlotParams{XAxisCol: "Epoch", TracesCol: "Run"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[0].On = true // TracesCol itself is not plotted
+	cols[2].On = true
+
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type stroke struct {
+		color color.Color
+		width vg.Length
+		path  vg.Path
+	}
+	acts, dc := drawPlot(plt)
+	trX, trY := plt.Transforms(&dc)
+	var lines []stroke // strokes with a point per epoch
+	var cur stroke
+	for _, a := range acts {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			cur.color = a.Color
+		case *recorder.SetLineWidth:
+			cur.width = a.Width
+		case *recorder.Stroke:
+			if len(a.Path) == neps {
+				cur.path = a.Path
+				lines = append(lines, cur)
+			}
+		}
+	}
+	if len(lines) != nruns+1 {
+		t.Fatalf("Traces: number of lines drawn: %d != %d", len(lines), nruns+1)
+	}
+	near := func(a, b vg.Length) bool { return math.Abs(float64(a-b)) < 1.0e-6 }
+	for i := 0; i < nruns; i++ {
+		ln := lines[i]
+		if _, _, _, a := ln.color.RGBA(); a == 0xffff {
+			t.Errorf("Traces: trace %d should be faint", i)
+		}
+		if !near(ln.path[1].Pos.Y, trY(float64(i))) {
+			t.Errorf("Traces: trace %d epoch 1 drawn at %v, not y = %d", i, ln.path[1].Pos.Y, i)
+		}
+	}
+	mn := lines[nruns]
+	if mn.width != vg.Points(2*pp.LineWidth) {
+		t.Errorf("Traces: mean line width: %v", mn.width)
+	}
+	if _, _, _, a := mn.color.RGBA(); a != 0xffff {
+		t.Errorf("Traces: mean line should be opaque: %v", color.RGBAModel.Convert(mn.color))
+	}
+	for ep := 0; ep < neps; ep++ {
+		pt := mn.path[ep].Pos // mean of ep * (0, 1, 2)
+		if !near(pt.X, trX(float64(ep))) || !near(pt.Y, trY(float64(ep))) {
+			t.Errorf("Traces: mean at epoch %d drawn at %v", ep, pt)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// Trends are the types of least-squares fit lines that can be
+// overlaid on a series in an XY plot.
+type Trends int32 //enums:enum
+
+const (
+	// NoTrend does not draw a fit line
+	NoTrend Trends = iota
+
+	// Linear draws a straight line fit: y = a + b x
+	Linear
+
+	// Poly2 draws a second-order polynomial fit: y = a + b x + c x^2
+	Poly2
+)
+
+// TrendFit is a least-squares fit of a Trend to a series of XY points
+type TrendFit struct {
+
+	// type of trend that was fit
+	Type Trends
+
+	// polynomial coefficients of the fit, starting with the intercept,
+	// i.e., y = Coeffs[0] + Coeffs[1] x + Coeffs[2] x^2
+	Coeffs []float64
+
+	// range of X values of the points that were fit
+	XMin, XMax float64
+}
+
+// FitTrend returns the least-squares fit of given type of trend to the
+// given XY points, using stat.LinearRegression for a Linear trend, and
+// a QR-based solution of the polynomial design matrix for Poly2.
+// Points with NaN X or Y values are skipped.
+// Returns an error if there are not enough distinct points to fit.
+func FitTrend(xy plotter.XYer, tr Trends) (*TrendFit, error) {
+	xs := make([]float64, 0, xy.Len())
+	ys := make([]float64, 0, xy.Len())
+	tf := &TrendFit{Type: tr, XMin: math.Inf(1), XMax: math.Inf(-1)}
+	for i := 0; i < xy.Len(); i++ {
+		x, y := xy.XY(i)
+		if math.IsNaN(x) || math.IsNaN(y) {
+			continue
+		}
+		xs = append(xs, x)
+		ys = append(ys, y)
+		tf.XMin = math.Min(tf.XMin, x)
+		tf.XMax = math.Max(tf.XMax, x)
+	}
+	n := len(xs)
+	if n < int(tr)+1 || !(tf.XMax > tf.XMin) {
+		return nil, fmt.Errorf("eplot.FitTrend: not enough distinct points: %d to fit %s trend", n, tr)
+	}
+	switch tr {
+	case Linear:
+		a, b := stat.LinearRegression(xs, ys, nil, false)
+		tf.Coeffs = []float64{a, b}
+	case Poly2:
+		a := mat.NewDense(n, 3, nil)
+		for i, x := range xs {
+			a.Set(i, 0, 1)
+			a.Set(i, 1, x)
+			a.Set(i, 2, x*x)
+		}
+		var c mat.VecDense
+		if err := c.SolveVec(a, mat.NewVecDense(n, ys)); err != nil {
+			return nil, fmt.Errorf("eplot.FitTrend: %w", err)
+		}
+		tf.Coeffs = []float64{c.AtVec(0), c.AtVec(1), c.AtVec(2)}
+	default:
+		return nil, fmt.Errorf("eplot.FitTrend: no trend to fit for: %s", tr)
+	}
+	return tf, nil
+}
+
+// Value returns the fit value at given x
+func (tf *TrendFit) Value(x float64) float64 {
+	y := 0.0
+	xp := 1.0
+	for _, c := range tf.Coeffs {
+		y += c * xp
+		xp *= x
+	}
+	return y
+}
+
+// String returns the equation of the fit, e.g., y = 2x + 1
+func (tf *TrendFit) String() string {
+	var b strings.Builder
+	b.WriteString("y = ")
+	for i := len(tf.Coeffs) - 1; i >= 0; i-- {
+		c := tf.Coeffs[i]
+		switch {
+		case i == len(tf.Coeffs)-1:
+			if c < 0 {
+				b.WriteString("-")
+			}
+		case c < 0:
+			b.WriteString(" - ")
+		default:
+			b.WriteString(" + ")
+		}
+		fmt.Fprintf(&b, "%.3g", math.Abs(c))
+		switch i {
+		case 1:
+			b.WriteString("x")
+		case 2:
+			b.WriteString("x²")
+		}
+	}
+	return b.String()
+}
+
+// XYs returns n points along the fit, evenly spaced over the X range
+func (tf *TrendFit) XYs(n int) plotter.XYs {
+	if tf.Type == Linear {
+		n = 2
+	}
+	n = max(n, 2)
+	pts := make(plotter.XYs, n)
+	for i := range pts {
+		x := tf.XMin + (tf.XMax-tf.XMin)*float64(i)/float64(n-1)
+		pts[i].X = x
+		pts[i].Y = tf.Value(x)
+	}
+	return pts
+}
+
+// AddTrend fits given type of trend to given XY points and adds it to the
+// plot as a dashed line in given color and width, with a legend entry
+// showing the fit equation if lbl is non-empty.  Returns the fit.
+func AddTrend(plt *plot.Plot, xy plotter.XYer, tr Trends, clr color.Color, width vg.Length, lbl string) (*TrendFit, error) {
+	tf, err := FitTrend(xy, tr)
+	if err != nil {
+		return nil, err
+	}
+	ln, err := plotter.NewLine(tf.XYs(50))
+	if err != nil {
+		return nil, err
+	}
+	ln.LineStyle.Color = clr
+	ln.LineStyle.Width = width
+	ln.LineStyle.Dashes = []vg.Length{vg.Points(6), vg.Points(3)}
+	plt.Add(ln)
+	if lbl != "" {
+		plt.Legend.Add(lbl+" "+tf.String(), ln)
+	}
+	return tf, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestFitTrend(t *testing.T) {
+	lin := make(plotter.XYs, 10)
+	quad := make(plotter.XYs, 10)
+	for i := range lin {
+		x := float64(i) - 3
+		lin[i] = plotter.XY{X: x, Y: 2*x + 1}
+		quad[i] = plotter.XY{X: x, Y: .5*x*x - x + 3}
+	}
+	tf, err := FitTrend(lin, Linear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range lin {
+		if math.Abs(tf.Value(p.X)-p.Y) > 1e-9 {
+			t.Errorf("Linear fit at %v: %v != %v", p.X, tf.Value(p.X), p.Y)
+		}
+	}
+	if s := tf.String(); s != "y = 2x + 1" {
+		t.Errorf("Linear fit string: %q", s)
+	}
+	fl := tf.XYs(50)
+	if len(fl) != 2 || fl[0].X != -3 || fl[1].X != 6 || math.Abs(fl[1].Y-13) > 1e-9 {
+		t.Errorf("Linear fit line: %v", fl)
+	}
+
+	tf, err = FitTrend(quad, Poly2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{3, -1, .5}
+	for i, c := range tf.Coeffs {
+		if math.Abs(c-want[i]) > 1e-9 {
+			t.Errorf("Poly2 fit coeffs: %v != %v", tf.Coeffs, want)
+			break
+		}
+	}
+
+	nan := append(plotter.XYs{}, lin...)
+	nan[2].Y = math.NaN()
+	nan[5].X = math.NaN()
+	tf, err = FitTrend(nan, Linear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(tf.Coeffs[0]-1) > 1e-9 || math.Abs(tf.Coeffs[1]-2) > 1e-9 {
+		t.Errorf("Linear fit with NaN points: %v != [1 2]", tf.Coeffs)
+	}
+	nan = plotter.XYs{{X: 0, Y: 1}, {X: math.NaN(), Y: 2}}
+	if _, err := FitTrend(nan, Linear); err == nil {
+		t.Error("FitTrend: expected error for a single non-NaN point")
+	}
+
+	if _, err := FitTrend(lin[:1], Linear); err == nil {
+		t.Error("FitTrend: expected error for a single point")
+	}
+	if _, err := FitTrend(lin[:2], Poly2); err == nil {
+		t.Error("FitTrend: expected error for two points with Poly2")
+	}
+}
+
+func TestGenPlotXYTrend(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 8)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("X", ri, float64(ri))
+		dt.SetCellFloat("Y", ri, 3*float64(ri)-2)
+	}
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	cols[1].Trend = Linear
+
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 2 || lg[0] != "Y" || !strings.HasPrefix(lg[1], "Y y = ") { // series + trend
+		t.Errorf("GenPlotXY Trend: legend entries: %v", lg)
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // Plot2DType is the [types.Type] for [Plot2D]
-var Plot2DType = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.Plot2D", IDName: "plot2-d", Doc: "Plot2D is a Cogent Core Widget that provides a 2D plot of selected columns of etable data", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Methods: []types.Method{{Name: "SaveSVG", Doc: "SaveSVG saves the plot to an svg -- first updates to ensure that plot is current", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "SavePNG", Doc: "SavePNG saves the current plot to a png, capturing current render", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "SaveCSV", Doc: "SaveCSV saves the Table data to a csv (comma-separated values) file with headers (any delim)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname", "delim"}}, {Name: "SaveAll", Doc: "SaveAll saves the current plot to a png, svg, and the data to a tsv -- full save\nAny extension is removed and appropriate extensions are added", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "OpenCSV", Doc: "OpenCSV opens the Table data from a csv (comma-separated values) file (or any delim)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"filename", "delim"}}, {Name: "SetColsByName", Doc: "SetColsByName turns cols On or Off if their name contains given string", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"nameContains", "on"}}}, Embeds: []types.Field{{Name: "Layout"}}, Fields: []types.Field{{Name: "Table", Doc: "the idxview of the table that we're plotting"}, {Name: "Params", Doc: "the overall plot parameters"}, {Name: "Cols", Doc: "the parameters for each column of the table"}, {Name: "Plot", Doc: "the gonum plot that actually does the plotting -- always save the last one generated"}, {Name: "ConfigPlotFunc", Doc: "ConfigPlotFunc is a function to call to configure [Plot2D.Plot], the gonum plot that\nactually does the plotting. It is called after [Plot] is generated, and properties\nof [Plot] can be modified in it. Properties of [Plot] should not be modified outside\nof this function, as doing so will have no effect."}, {Name: "SVGFile", Doc: "current svg file"}, {Name: "DataFile", Doc: "current csv data file"}, {Name: "InPlot", Doc: "currently doing a plot"}}, Instance: &Plot2D{}})
+var Plot2DType = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.Plot2D", IDName: "plot2-d", Doc: "Plot2D is a Cogent Core Widget that provides a 2D plot of selected columns of etable data", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Methods: []types.Method{{Name: "SaveSVG", Doc: "SaveSVG saves the plot to an svg -- first updates to ensure that plot is current", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "SavePNG", Doc: "SavePNG saves the current plot to a png, capturing current render", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "SaveCSV", Doc: "SaveCSV saves the Table data to a csv (comma-separated values) file with headers (any delim)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname", "delim"}}, {Name: "SaveAll", Doc: "SaveAll saves the current plot to a png, svg, and the data to a tsv -- full save\nAny extension is removed and appropriate extensions are added", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}}, {Name: "OpenCSV", Doc: "OpenCSV opens the Table data from a csv (comma-separated values) file (or any delim)", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"filename", "delim"}}, {Name: "SetColsByName", Doc: "SetColsByName turns cols On or Off if their name contains given string", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"nameContains", "on"}}, {Name: "ResetView", Doc: "ResetView resets any zoom and pan of the plot view to the default,\nwhich is otherwise preserved across plot updates.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}}, {Name: "SaveConfig", Doc: "SaveConfig saves the full plot configuration (Params and Cols) to\ngiven JSON file, which can be loaded later with OpenConfig.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}, Returns: []string{"error"}}, {Name: "OpenConfig", Doc: "OpenConfig opens the full plot configuration (Params and Cols) from given\nJSON file, saved by SaveConfig, and updates the plot.  Column parameters\nare applied to the columns with the same name in the current table --\nany others are logged and skipped.", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Args: []string{"fname"}, Returns: []string{"error"}}}, Embeds: []types.Field{{Name: "Layout"}}, Fields: []types.Field{{Name: "Table", Doc: "the idxview of the table that we're plotting"}, {Name: "Params", Doc: "the overall plot parameters"}, {Name: "Cols", Doc: "the parameters for each column of the table"}, {Name: "Plot", Doc: "the gonum plot that actually does the plotting -- always save the last one generated"}, {Name: "ConfigPlotFunc", Doc: "ConfigPlotFunc is a function to call to configure [Plot2D.Plot], the gonum plot that\nactually does the plotting. It is called after [Plot] is generated, and properties\nof [Plot] can be modified in it. Properties of [Plot] should not be modified outside\nof this function, as doing so will have no effect."}, {Name: "SelectRowsFunc", Doc: "SelectRowsFunc is called with the SelectedRows after a region of the\nplot is selected by dragging on it (when zoom and pan is off), e.g., to\nshow the selected rows in another view."}, {Name: "SelectedRows", Doc: "the source table row indexes of the points in the region of the plot\nmost recently selected by dragging on it"}, {Name: "Readout", Doc: "show a readout in the toolbar of the data values under the pointer in an\nXY plot: the X and Y position, and the value of each On column at the\nnearest X value"}, {Name: "SVGFile", Doc: "current svg file"}, {Name: "DataFile", Doc: "current csv data file"}, {Name: "InPlot", Doc: "currently doing a plot"}, {Name: "ViewScale", Doc: "the zoom scale of the plot view set by the user, which is restored\nafter each plot update so that live-updating plots stay zoomed in\n-- 0 if the view has not been zoomed or panned (see ResetView)"}, {Name: "ViewTranslate", Doc: "the pan translation of the plot view set by the user, which is restored\nafter each plot update along with ViewScale"}}, Instance: &Plot2D{}})
 
 // NewPlot2D adds a new [Plot2D] with the given name to the given parent:
 // Plot2D is a Cogent Core Widget that provides a 2D plot of selected columns of etable data
@@ -34,6 +34,18 @@ func (t *Plot2D) SetParams(v PlotParams) *Plot2D { t.Params = v; return t }
 // of this function, as doing so will have no effect.
 func (t *Plot2D) SetConfigPlotFunc(v func()) *Plot2D { t.ConfigPlotFunc = v; return t }
 
+// SetSelectRowsFunc sets the [Plot2D.SelectRowsFunc]:
+// SelectRowsFunc is called with the SelectedRows after a region of the
+// plot is selected by dragging on it (when zoom and pan is off), e.g., to
+// show the selected rows in another view.
+func (t *Plot2D) SetSelectRowsFunc(v func(rows []int)) *Plot2D { t.SelectRowsFunc = v; return t }
+
+// SetReadout sets the [Plot2D.Readout]:
+// show a readout in the toolbar of the data values under the pointer in an
+// XY plot: the X and Y position, and the value of each On column at the
+// nearest X value
+func (t *Plot2D) SetReadout(v bool) *Plot2D { t.Readout = v; return t }
+
 // SetSVGFile sets the [Plot2D.SVGFile]:
 // current svg file
 func (t *Plot2D) SetSVGFile(v core.Filename) *Plot2D { t.SVGFile = v; return t }
@@ -45,6 +57,6 @@ func (t *Plot2D) SetDataFile(v core.Filename) *Plot2D { t.DataFile = v; return t
 // SetTooltip sets the [Plot2D.Tooltip]
 func (t *Plot2D) SetTooltip(v string) *Plot2D { t.Tooltip = v; return t }
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.PlotParams", IDName: "plot-params", Doc: "PlotParams are parameters for overall plot", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "Title", Doc: "optional title at top of plot"}, {Name: "Type", Doc: "type of plot to generate.  For a Bar plot, items are plotted ordinally by row and the XAxis is optional"}, {Name: "Lines", Doc: "whether to plot lines"}, {Name: "Points", Doc: "whether to plot points with symbols"}, {Name: "LineWidth", Doc: "width of lines"}, {Name: "PointSize", Doc: "size of points"}, {Name: "PointShape", Doc: "the shape used to draw points"}, {Name: "BarWidth", Doc: "width of bars for bar plot, as fraction of available space (1 = no gaps)"}, {Name: "NegXDraw", Doc: "draw lines that connect points with a negative X-axis direction -- otherwise these are treated as breaks between repeated series and not drawn"}, {Name: "Scale", Doc: "overall scaling factor -- the larger the number, the larger the fonts are relative to the graph"}, {Name: "XAxisCol", Doc: "what column to use for the common X axis -- if empty or not found, the row number is used.  This optional for Bar plots -- if present and LegendCol is also present, then an extra space will be put between X values."}, {Name: "LegendCol", Doc: "optional column for adding a separate colored / styled line or bar according to this value -- acts just like a separate Y variable, crossed with Y variables"}, {Name: "XAxisRot", Doc: "rotation of the X Axis labels, in degrees"}, {Name: "XAxisLabel", Doc: "optional label to use for XAxis instead of column name"}, {Name: "YAxisLabel", Doc: "optional label to use for YAxis -- if empty, first column name is used"}, {Name: "Plot", Doc: "our plot, for update method"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.PlotParams", IDName: "plot-params", Doc: "PlotParams are parameters for overall plot", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "Title", Doc: "optional title at top of plot"}, {Name: "Type", Doc: "type of plot to generate.  For a Bar plot, items are plotted ordinally by row and the XAxis is optional"}, {Name: "Lines", Doc: "whether to plot lines"}, {Name: "Points", Doc: "whether to plot points with symbols"}, {Name: "LineWidth", Doc: "width of lines"}, {Name: "PointSize", Doc: "size of points"}, {Name: "PointShape", Doc: "the shape used to draw points"}, {Name: "BarWidth", Doc: "width of bars for bar plot, as fraction of available space (1 = no gaps)"}, {Name: "BarValueLabels", Doc: "draw the value of each bar as a text label at the end of the bar in a Bar plot (beyond any error bar), formatted using YTickFormat"}, {Name: "NegXDraw", Doc: "draw lines that connect points with a negative X-axis direction -- otherwise these are treated as breaks between repeated series and not drawn"}, {Name: "XAxisSort", Doc: "sort the rows by the XAxisCol values before plotting, so that lines are drawn in order of increasing X -- otherwise non-monotonic X values are reported, and result in breaks in the lines (or zig-zag lines if NegXDraw is set)"}, {Name: "Scale", Doc: "overall scaling factor -- the larger the number, the larger the fonts are relative to the graph"}, {Name: "XAxisCol", Doc: "what column to use for the common X axis -- if empty or not found, the row number is used.  This optional for Bar plots -- if present and LegendCol is also present, then an extra space will be put between X values.  For Bar plots, a String column provides the category label for each bar."}, {Name: "LegendCol", Doc: "optional column for adding a separate colored / styled line or bar according to this value -- acts just like a separate Y variable, crossed with Y variables"}, {Name: "Aggregate", Doc: "plot the mean and standard error of the mean (as error bars) of the rows with the same X value (and AggGroupCol value if set), instead of the individual rows -- e.g., to show the mean across runs instead of each individual run.  LegendCol is not used."}, {Name: "AggGroupCol", Doc: "optional column whose values define separate aggregated series when Aggregate is on, e.g., a condition column -- plotted as the legend"}, {Name: "TracesCol", Doc: "optional column whose values define separate groups of rows, e.g., runs, that are each plotted in an XY plot as a faint individual trace, with the mean across the groups at each X value plotted as a bold line on top -- a \"spaghetti plot with mean\".  Not used with Aggregate."}, {Name: "XAxisRot", Doc: "rotation of the X Axis labels, in degrees -- if 0, long category labels in a Bar plot with a String XAxisCol are rotated to avoid overlap"}, {Name: "XAxisLabel", Doc: "optional label to use for XAxis instead of column name"}, {Name: "YAxisLabel", Doc: "optional label to use for YAxis -- if empty, first column name is used"}, {Name: "XRangePad", Doc: "fraction of the data range to add as padding at each end of the X axis when its range is automatic (not fixed), e.g., 0.05, so that points at the edges are not drawn on the frame"}, {Name: "YRangePad", Doc: "fraction of the data range to add as padding at each end of the Y axis when its range is automatic (not fixed), e.g., 0.05, so that points at the edges are not drawn on the frame"}, {Name: "XTickFormat", Doc: "optional fmt-style format string for the X axis tick labels, e.g., \"%.2f\" -- if empty, default formatting is used"}, {Name: "YTickFormat", Doc: "optional fmt-style format string for the Y axis tick labels, e.g., \"%.2f\" -- if empty, default formatting is used"}, {Name: "XNTicks", Doc: "approximate number of major ticks on the X axis, at nice round values -- if 0, the default ticks are used"}, {Name: "YNTicks", Doc: "approximate number of major ticks on the Y axis, at nice round values -- if 0, the default ticks are used"}, {Name: "MaxPoints", Doc: "maximum number of points to plot per line in an XY plot -- if > 0, longer series are downsampled using min / max bucketing, which preserves spikes, for faster rendering.  The table data is not affected."}, {Name: "TargetMin", Doc: "lower Y value of an optional target region, drawn as a translucent horizontal band behind the data, e.g., an acceptable error range.  Only drawn if TargetMax > TargetMin."}, {Name: "TargetMax", Doc: "upper Y value of an optional target region, drawn as a translucent horizontal band behind the data.  Only drawn if TargetMax > TargetMin."}, {Name: "TargetColor", Doc: "color of the target region band, which should be translucent -- if nil, a translucent primary color is used"}, {Name: "MinUpdateInterval", Doc: "minimum interval between plot updates by GoUpdatePlot, e.g., 100ms, so that calling it on every trial of a fast training run does not overwhelm rendering -- rapid calls are coalesced into one update at the end of the interval, showing the latest data.  0 = update on every call."}, {Name: "Plot", Doc: "our plot, for update method"}}})
 
-var _ = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.ColParams", IDName: "col-params", Doc: "ColParams are parameters for plotting one column of data", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "On", Doc: "whether to plot this column"}, {Name: "Col", Doc: "name of column we're plotting"}, {Name: "Lines", Doc: "whether to plot lines; uses the overall plot option if unset"}, {Name: "Points", Doc: "whether to plot points with symbols; uses the overall plot option if unset"}, {Name: "LineWidth", Doc: "the width of lines; uses the overall plot option if unset"}, {Name: "PointSize", Doc: "the size of points; uses the overall plot option if unset"}, {Name: "PointShape", Doc: "the shape used to draw points; uses the overall plot option if unset"}, {Name: "Range", Doc: "effective range of data to plot -- either end can be fixed"}, {Name: "FullRange", Doc: "full actual range of data -- only valid if specifically computed"}, {Name: "Color", Doc: "color to use when plotting the line / column"}, {Name: "NTicks", Doc: "desired number of ticks"}, {Name: "Lbl", Doc: "if non-empty, this is an alternative label to use in plotting"}, {Name: "TensorIndex", Doc: "if column has n-dimensional tensor cells in each row, this is the index within each cell to plot -- use -1 to plot *all* indexes as separate lines"}, {Name: "ErrCol", Doc: "specifies a column containing error bars for this column"}, {Name: "IsString", Doc: "if true this is a string column -- plots as labels"}, {Name: "Plot", Doc: "our plot, for update method"}}})
+var _ = types.AddType(&types.Type{Name: "github.com/emer/etable/v2/eplot.ColParams", IDName: "col-params", Doc: "ColParams are parameters for plotting one column of data", Directives: []types.Directive{{Tool: "types", Directive: "add"}}, Fields: []types.Field{{Name: "On", Doc: "whether to plot this column"}, {Name: "Col", Doc: "name of column we're plotting"}, {Name: "Lines", Doc: "whether to plot lines; uses the overall plot option if unset"}, {Name: "Points", Doc: "whether to plot points with symbols; uses the overall plot option if unset"}, {Name: "LineWidth", Doc: "the width of lines; uses the overall plot option if unset"}, {Name: "PointSize", Doc: "the size of points; uses the overall plot option if unset"}, {Name: "PointShape", Doc: "the shape used to draw points; uses the overall plot option if unset"}, {Name: "Range", Doc: "effective range of data to plot -- either end can be fixed"}, {Name: "FullRange", Doc: "full actual range of data -- only valid if specifically computed"}, {Name: "Color", Doc: "color to use when plotting the line / column"}, {Name: "NTicks", Doc: "desired number of ticks"}, {Name: "Lbl", Doc: "if non-empty, this is an alternative label to use in plotting"}, {Name: "TensorIndex", Doc: "if column has n-dimensional tensor cells in each row, this is the index within each cell to plot -- use -1 to plot *all* indexes as separate lines"}, {Name: "ErrCol", Doc: "specifies a column containing error bars for this column"}, {Name: "Trend", Doc: "optional least-squares fit line to overlay on each series of this column in an XY plot, drawn dashed in the series color, with the fit equation in the legend"}, {Name: "SizeCol", Doc: "optional column whose values set the size of each point, for a bubble chart -- sizes are scaled from 0.5 to 3 times the PointSize over the range of values, with the point area proportional to the value"}, {Name: "ColorValCol", Doc: "optional column whose values set the color of each point, using ColorMap over the range of values, with a color bar added to the legend"}, {Name: "ColorMap", Doc: "the name of the color map to use for ColorValCol (ColdHot if empty)"}, {Name: "IsString", Doc: "if true this is a string column -- plots as labels"}, {Name: "Plot", Doc: "our plot, for update method"}}})
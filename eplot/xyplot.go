@@ -22,11 +22,51 @@ import (
 
 // GenPlotXY generates an XY (lines, points) plot, setting GPlot variable
 func (pl *Plot2D) GenPlotXY() {
-	plt := plot.New() // todo: not clear how to re-use, due to newtablexynames
-	plt.Title.Text = pl.Params.Title
-	plt.X.Label.Text = pl.XLabel()
-	plt.Y.Label.Text = pl.YLabel()
+	plt, err := GenPlotXY(pl.Table, &pl.Params, pl.Cols)
+	if err != nil {
+		return
+	}
+	pl.Plot = plt
+	if pl.ConfigPlotFunc != nil {
+		pl.ConfigPlotFunc()
+	}
+}
 
+// xyPlotTable returns the view of the table, with plot and column params,
+// that GenPlotXY plots: the AggregateTable if Aggregate is set, or the
+// TracesMeanTable if TracesCol is set, and otherwise the given ones.
+func xyPlotTable(ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (*etable.IndexView, *PlotParams, []*ColParams, error) {
+	switch {
+	case pp.Aggregate:
+		return AggregateTable(ix, pp, cols)
+	case pp.TracesCol != "":
+		return TracesMeanTable(ix, pp, cols)
+	}
+	return ix, pp, cols, nil
+}
+
+// GenPlotXY generates an XY (lines, points) gonum plot of the given
+// IndexView of a table, using given overall plot params and column params,
+// which must have one entry per column of the table (see NewColsParams).
+// It does not depend on the Plot2D widget or any GUI, so it can be used
+// to generate plots headlessly, e.g., for saving to SVG or PNG files.
+func GenPlotXY(ix *etable.IndexView, pp *PlotParams, cols []*ColParams) (*plot.Plot, error) {
+	if ix == nil || ix.Table == nil {
+		return nil, errors.New("eplot.GenPlotXY: table is nil")
+	}
+	if len(cols) != ix.Table.NumCols() {
+		return nil, fmt.Errorf("eplot.GenPlotXY: number of column params: %d != number of table columns: %d", len(cols), ix.Table.NumCols())
+	}
+	tix, tpp, tcols := ix, pp, cols // individual rows, for TracesCol
+	ix, pp, cols, err := xyPlotTable(ix, pp, cols)
+	if err != nil {
+		return nil, err
+	}
+	dt := ix.Table
+	plt := plot.New() // todo: not clear how to re-use, due to newtablexynames
+	plt.Title.Text = pp.Title
+	plt.X.Label.Text = pp.XLabel(cols)
+	plt.Y.Label.Text = pp.YLabel(cols)
 	plt.BackgroundColor = colors.Scheme.Surface
 
 	clr := colors.Scheme.OnSurface
@@ -43,21 +83,32 @@ func (pl *Plot2D) GenPlotXY() {
 	plt.Y.Tick.Label.Color = clr
 
 	// process xaxis first
-	xi, xview, xbreaks, err := pl.PlotXAxis(plt, pl.Table)
+	var xcats []string // categories for a String X column
+	var xcmap map[string]int
+	if xi, err := dt.ColIndexTry(pp.XAxisCol); err == nil && dt.Cols[xi].DataType() == etensor.STRING {
+		xcats, xcmap = XCategories(ix, xi, pp.XAxisSort)
+	}
+	xi, xview, xbreaks, err := plotXAxis(plt, ix, pp, cols, xcmap)
 	if err != nil {
-		return
+		return nil, err
+	}
+	xp := cols[xi]
+	pp.AddTarget(plt)
+	if tpp.TracesCol != "" && !tpp.Aggregate { // drawn behind the mean
+		if _, err := AddTraces(plt, tix, tpp, tcols); err != nil {
+			return nil, err
+		}
 	}
-	xp := pl.Cols[xi]
 
 	var lsplit *etable.Splits
 	nleg := 1
-	if pl.Params.LegendCol != "" {
-		_, err = pl.Table.Table.ColIndexTry(pl.Params.LegendCol)
+	if pp.LegendCol != "" {
+		_, err = dt.ColIndexTry(pp.LegendCol)
 		if err != nil {
 			slog.Error("eplot.LegendCol", "err", err.Error())
 		} else {
-			errors.Log(xview.SortStableColNames([]string{pl.Params.LegendCol, xp.Col}, etable.Ascending))
-			lsplit = split.GroupBy(xview, []string{pl.Params.LegendCol})
+			errors.Log(xview.SortStableColNames([]string{pp.LegendCol, xp.Col}, etable.Ascending))
+			lsplit = split.GroupBy(xview, []string{pp.LegendCol})
 			nleg = max(lsplit.Len(), 1)
 		}
 	}
@@ -65,7 +116,7 @@ func (pl *Plot2D) GenPlotXY() {
 	var firstXY *TableXY
 	var strCols []*ColParams
 	nys := 0
-	for _, cp := range pl.Cols {
+	for _, cp := range cols {
 		if !cp.On {
 			continue
 		}
@@ -74,7 +125,7 @@ func (pl *Plot2D) GenPlotXY() {
 			continue
 		}
 		if cp.TensorIndex < 0 {
-			yc := pl.Table.Table.ColByName(cp.Col)
+			yc := dt.ColByName(cp.Col)
 			_, sz := yc.RowCellSize()
 			nys += sz
 		} else {
@@ -89,12 +140,14 @@ func (pl *Plot2D) GenPlotXY() {
 	}
 
 	if nys == 0 {
-		return
+		return nil, errors.New("eplot.GenPlotXY: no columns are turned on to plot")
 	}
 
 	firstXY = nil
 	yidx := 0
-	for _, cp := range pl.Cols {
+	colorBars := map[string]*PointStyler{} // ColorValCol color bars for the legend
+	var colorBarCols []string
+	for _, cp := range cols {
 		if !cp.On || cp == xp {
 			continue
 		}
@@ -107,14 +160,14 @@ func (pl *Plot2D) GenPlotXY() {
 			if lsplit != nil && len(lsplit.Values) > li {
 				leg = lsplit.Values[li][0]
 				lview = lsplit.Splits[li]
-				_, _, xbreaks, _ = pl.PlotXAxis(plt, lview)
+				_, _, xbreaks, _ = plotXAxis(plt, lview, pp, cols, xcmap)
 			}
 			stRow := 0
 			for bi, edRow := range xbreaks {
 				nidx := 1
 				stidx := cp.TensorIndex
 				if cp.TensorIndex < 0 { // do all
-					yc := pl.Table.Table.ColByName(cp.Col)
+					yc := dt.ColByName(cp.Col)
 					_, sz := yc.RowCellSize()
 					nidx = sz
 					stidx = 0
@@ -127,6 +180,7 @@ func (pl *Plot2D) GenPlotXY() {
 					if xy == nil {
 						continue
 					}
+					xy.XCats = xcmap
 					if firstXY == nil {
 						firstXY = xy
 					}
@@ -145,15 +199,25 @@ func (pl *Plot2D) GenPlotXY() {
 						clr = colors.Spaced(idx)
 						lbl = fmt.Sprintf("%s_%02d", lbl, idx)
 					}
-					if cp.Lines.Or(pl.Params.Lines) && cp.Points.Or(pl.Params.Points) {
-						lns, pts, _ = plotter.NewLinePoints(xy)
-					} else if cp.Points.Or(pl.Params.Points) {
-						pts, _ = plotter.NewScatter(xy)
+					var pxy interface {
+						plotter.XYer
+						plotter.YErrorer
+					} = xy
+					bubble := cp.SizeCol != "" || cp.ColorValCol != ""
+					if !bubble { // point styles are indexed by table row
+						if idx := downsampleIndexes(xy, pp.MaxPoints); idx != nil {
+							pxy = &downsampledXY{xy: xy, idx: idx}
+						}
+					}
+					if cp.Lines.Or(pp.Lines) && cp.Points.Or(pp.Points) {
+						lns, pts, _ = plotter.NewLinePoints(pxy)
+					} else if cp.Points.Or(pp.Points) {
+						pts, _ = plotter.NewScatter(pxy)
 					} else {
-						lns, _ = plotter.NewLine(xy)
+						lns, _ = plotter.NewLine(pxy)
 					}
 					if lns != nil {
-						lns.LineStyle.Width = vg.Points(cp.LineWidth.Or(pl.Params.LineWidth))
+						lns.LineStyle.Width = vg.Points(cp.LineWidth.Or(pp.LineWidth))
 						lns.LineStyle.Color = clr
 						plt.Add(lns)
 						if bi == 0 {
@@ -162,18 +226,39 @@ func (pl *Plot2D) GenPlotXY() {
 					}
 					if pts != nil {
 						pts.GlyphStyle.Color = clr
-						pts.GlyphStyle.Radius = vg.Points(cp.PointSize.Or(pl.Params.PointSize))
-						pts.GlyphStyle.Shape = cp.PointShape.Or(pl.Params.PointShape).Glyph()
+						pts.GlyphStyle.Radius = vg.Points(cp.PointSize.Or(pp.PointSize))
+						pts.GlyphStyle.Shape = cp.PointShape.Or(pp.PointShape).Glyph()
+						if bubble {
+							ps, err := NewPointStyler(ix, xy, cp, pts.GlyphStyle)
+							if err != nil {
+								slog.Error("eplot.GenPlotXY", "err", err.Error())
+							} else {
+								pts.GlyphStyleFunc = ps.GlyphStyle
+							}
+							if ps != nil && cp.ColorValCol != "" && colorBars[cp.ColorValCol] == nil {
+								colorBars[cp.ColorValCol] = ps
+								colorBarCols = append(colorBarCols, cp.ColorValCol)
+							}
+						}
 						plt.Add(pts)
 						if lns == nil && bi == 0 {
 							plt.Legend.Add(lbl, pts)
 						}
 					}
+					if cp.Trend != NoTrend {
+						tlbl := ""
+						if bi == 0 {
+							tlbl = lbl
+						}
+						if _, err := AddTrend(plt, xy, cp.Trend, clr, vg.Points(cp.LineWidth.Or(pp.LineWidth)), tlbl); err != nil {
+							slog.Error("eplot.GenPlotXY Trend", "err", err.Error())
+						}
+					}
 					if cp.ErrCol != "" {
-						ec := pl.Table.Table.ColIndex(cp.ErrCol)
+						ec := dt.ColIndex(cp.ErrCol)
 						if ec >= 0 {
 							xy.ErrCol = ec
-							eb, _ := plotter.NewYErrorBars(xy)
+							eb, _ := plotter.NewYErrorBars(pxy)
 							eb.LineStyle.Color = clr
 							plt.Add(eb)
 						}
@@ -184,9 +269,13 @@ func (pl *Plot2D) GenPlotXY() {
 		}
 		yidx++
 	}
+	for _, cc := range colorBarCols {
+		colorBars[cc].AddColorBar(plt, cc, 5)
+	}
 	if firstXY != nil && len(strCols) > 0 {
 		for _, cp := range strCols {
 			xy, _ := NewTableXYName(xview, xi, xp.TensorIndex, cp.Col, cp.TensorIndex, firstXY.YRange)
+			xy.XCats = xcmap
 			xy.LblCol = xy.YCol
 			xy.YCol = firstXY.YCol
 			xy.YIndex = firstXY.YIndex
@@ -197,25 +286,19 @@ func (pl *Plot2D) GenPlotXY() {
 		}
 	}
 
-	// Use string labels for X axis if X is a string
-	xc := pl.Table.Table.Cols[xi]
-	if xc.DataType() == etensor.STRING {
-		xcs := xc.(*etensor.String)
-		vals := make([]string, pl.Table.Len())
-		for i, dx := range pl.Table.Indexes {
-			vals[i] = xcs.Values[dx]
-		}
-		plt.NominalX(vals...)
+	// Use category labels for X axis if X is a string
+	if len(xcats) > 0 {
+		plt.NominalX(xcats...)
 	}
 
+	pp.PadRanges(plt, cols, xi)
+	pp.ConfigTicks(plt, len(xcats) > 0)
+
 	plt.Legend.Top = true
-	plt.X.Tick.Label.Rotation = math.Pi * (pl.Params.XAxisRot / 180)
-	if pl.Params.XAxisRot > 10 {
+	plt.X.Tick.Label.Rotation = math.Pi * (pp.XAxisRot / 180)
+	if pp.XAxisRot > 10 {
 		plt.X.Tick.Label.YAlign = draw.YCenter
 		plt.X.Tick.Label.XAlign = draw.XRight
 	}
-	pl.Plot = plt
-	if pl.ConfigPlotFunc != nil {
-		pl.ConfigPlotFunc()
-	}
+	return plt, nil
 }
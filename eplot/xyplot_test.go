@@ -0,0 +1,290 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eplot
+
+import (
+	"bytes"
+	"log/slog"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/minmax"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// drawPlot draws given plot onto a 4 x 3 inch recorder canvas, returning
+// the recorded drawing actions and the data canvas within it, to test
+// what is plotted through the public gonum API.
+func drawPlot(plt *plot.Plot) ([]recorder.Action, draw.Canvas) {
+	rec := &recorder.Canvas{}
+	c := draw.Canvas{Canvas: rec, Rectangle: vg.Rectangle{Max: vg.Point{X: 4 * vg.Inch, Y: 3 * vg.Inch}}}
+	plt.Draw(c)
+	return rec.Actions, plt.DataCanvas(c)
+}
+
+// drawnStrings returns the text strings drawn by given recorded actions
+func drawnStrings(acts []recorder.Action) []string {
+	var strs []string
+	for _, a := range acts {
+		if fs, ok := a.(*recorder.FillString); ok {
+			strs = append(strs, fs.String)
+		}
+	}
+	return strs
+}
+
+// legendEntries returns the labels of the entries of the legend of given
+// plot, as drawn, so entries with empty labels are not included.
+func legendEntries(plt *plot.Plot) []string {
+	rec := &recorder.Canvas{}
+	plt.Legend.Draw(draw.Canvas{Canvas: rec, Rectangle: vg.Rectangle{Max: vg.Point{X: 4 * vg.Inch, Y: 3 * vg.Inch}}})
+	return drawnStrings(rec.Actions)
+}
+
+func TestGenPlotXY(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Trial", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 10)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("Trial", ri, float64(ri))
+		dt.SetCellFloat("Err", ri, 1/float64(ri+1))
+	}
+	pp := &PlotParams{XAxisCol: "Trial"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+
+	plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plt == nil {
+		t.Fatal("GenPlotXY: nil plot")
+	}
+	if lg := legendEntries(plt); !slices.Equal(lg, []string{"Err"}) {
+		t.Errorf("GenPlotXY: legend entries: %v != [Err]", lg)
+	}
+	if plt.X.Label.Text != "Trial" {
+		t.Errorf("GenPlotXY: X label: %v != Trial", plt.X.Label.Text)
+	}
+	if plt.Y.Label.Text != "Err" {
+		t.Errorf("GenPlotXY: Y label: %v != Err", plt.Y.Label.Text)
+	}
+	wt, err := plt.WriterTo(4*vg.Inch, 3*vg.Inch, "svg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := wt.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == 0 {
+		t.Error("GenPlotXY: empty svg output")
+	}
+
+	cols[1].On = false
+	_, err = GenPlotXY(etable.NewIndexView(dt), pp, cols)
+	if err == nil {
+		t.Error("GenPlotXY: expected error with no columns on")
+	}
+}
+
+func TestGenPlotXYPoints(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Trial", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 5)
+	for ri := 0; ri < dt.Rows; ri++ {
+		dt.SetCellFloat("Trial", ri, float64(ri))
+		dt.SetCellFloat("Err", ri, float64(ri%2))
+	}
+	dt.SetMetaData("Err:On", "+")
+	dt.SetMetaData("Err:Lines", "-")
+	dt.SetMetaData("Err:Points", "+")
+	dt.SetMetaData("Err:PointShape", "Square")
+	dt.SetMetaData("Err:PointSize", "4")
+	pp := &PlotParams{XAxisCol: "Trial"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	ep := cols[1]
+	if _, ok := ep.PointShape.Or(pp.PointShape).Glyph().(draw.SquareGlyph); !ok {
+		t.Errorf("PointShape Square: glyph is not SquareGlyph")
+	}
+
+	glyphWidth := func() vg.Length {
+		plt, err := GenPlotXY(etable.NewIndexView(dt), pp, cols)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gbs := plt.GlyphBoxes(plt)
+		if len(gbs) != dt.Rows {
+			t.Fatalf("GenPlotXY: number of glyph boxes: %d != %d", len(gbs), dt.Rows)
+		}
+		return gbs[0].Rectangle.Size().X
+	}
+	w4 := glyphWidth()
+	ep.PointSize.Set(8)
+	w8 := glyphWidth()
+	if w8 != 2*w4 {
+		t.Errorf("PointSize: glyph width at size 8: %v != 2 * width at size 4: %v", w8, w4)
+	}
+}
+
+func TestPlotXAxisSort(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 6)
+	xs := []float64{3, 1, 4, 0, 5, 2}
+	for ri, x := range xs {
+		dt.SetCellFloat("X", ri, x)
+		dt.SetCellFloat("Y", ri, x*x)
+	}
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	ix := etable.NewIndexView(dt)
+
+	_, _, xbreaks, err := PlotXAxis(plot.New(), ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xbreaks) == 1 {
+		t.Errorf("PlotXAxis: expected breaks for non-monotonic X: %v", xbreaks)
+	}
+
+	pp.XAxisSort = true
+	xi, xview, xbreaks, err := PlotXAxis(plot.New(), ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xbreaks) != 1 || xbreaks[0] != dt.Rows {
+		t.Errorf("PlotXAxis XAxisSort: breaks: %v", xbreaks)
+	}
+	xy, err := NewTableXYName(xview, xi, 0, "Y", 0, minmax.Range64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < xy.Len(); i++ {
+		x0, _ := xy.XY(i - 1)
+		x1, _ := xy.XY(i)
+		if x1 < x0 {
+			t.Errorf("XAxisSort: X not monotonic at %d: %v < %v", i, x1, x0)
+		}
+	}
+	if ix.Indexes[0] != 0 || ix.Indexes[1] != 1 {
+		t.Error("XAxisSort should not modify the source IndexView")
+	}
+
+	plt, err := GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Errorf("GenPlotXY XAxisSort: legend entries: %v, expected 1", lg)
+	}
+}
+
+func TestNonMonotonicReport(t *testing.T) {
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	dt := etable.New(etable.Schema{
+		{"X", etensor.FLOAT64, nil, nil},
+		{"Y", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for ri, x := range []float64{0, 2, 1, 3} {
+		dt.SetCellFloat("X", ri, x)
+		dt.SetCellFloat("Y", ri, float64(ri))
+	}
+	pp := &PlotParams{XAxisCol: "X"}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	for i := 0; i < 3; i++ {
+		if _, err := GenPlotXY(etable.NewIndexView(dt), pp, cols); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := strings.Count(buf.String(), "not monotonically"); n != 1 {
+		t.Errorf("non-monotonic X reported %d times, not once", n)
+	}
+
+	buf.Reset()
+	pp = &PlotParams{XAxisCol: "X", NegXDraw: true}
+	pp.Defaults()
+	if _, err := GenPlotXY(etable.NewIndexView(dt), pp, cols); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "not monotonically") {
+		t.Error("non-monotonic X reported with NegXDraw")
+	}
+}
+
+func TestCategoricalX(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Value", etensor.FLOAT64, nil, nil},
+	}, 5)
+	for ri, cond := range []string{"b", "a", "b", "c10", "c2"} {
+		dt.SetCellString("Cond", ri, cond)
+		dt.SetCellFloat("Value", ri, float64(ri))
+	}
+	ix := etable.NewIndexView(dt)
+	cats, cmap := XCategories(ix, 0, false)
+	if !reflect.DeepEqual(cats, []string{"b", "a", "c10", "c2"}) || cmap["c10"] != 2 {
+		t.Errorf("XCategories first occurrence: %v %v", cats, cmap)
+	}
+	cats, cmap = XCategories(ix, 0, true)
+	if !reflect.DeepEqual(cats, []string{"a", "b", "c2", "c10"}) || cmap["c10"] != 3 {
+		t.Errorf("XCategories sorted: %v %v", cats, cmap)
+	}
+
+	pp := &PlotParams{XAxisCol: "Cond", XAxisSort: true}
+	pp.Defaults()
+	cols := NewColsParams(dt, pp)
+	cols[1].On = true
+	plt, err := GenPlotXY(ix, pp, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lg := legendEntries(plt); len(lg) != 1 {
+		t.Fatalf("categorical X: legend entries: %v, expected 1", lg)
+	}
+	acts, dc := drawPlot(plt)
+	trX, _ := plt.Transforms(&dc)
+	var line vg.Path // the only stroked path with a point per row
+	for _, a := range acts {
+		if st, ok := a.(*recorder.Stroke); ok && len(st.Path) == dt.Rows {
+			line = st.Path
+		}
+	}
+	if line == nil {
+		t.Fatal("categorical X: line not drawn")
+	}
+	for i, x := range []float64{0, 1, 1, 2, 3} { // category indexes
+		if math.Abs(float64(line[i].Pos.X-trX(x))) > 1e-6 {
+			t.Errorf("categorical X: point %d drawn at %v, not x = %v", i, line[i].Pos.X, x)
+		}
+	}
+	var lbls []string
+	for _, tk := range plt.X.Tick.Marker.Ticks(plt.X.Min, plt.X.Max) {
+		lbls = append(lbls, tk.Label)
+	}
+	if !reflect.DeepEqual(lbls, cats) {
+		t.Errorf("categorical X: tick labels: %v != %v", lbls, cats)
+	}
+}
@@ -44,3 +44,46 @@ func (i Delims) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
 func (i *Delims) UnmarshalText(text []byte) error { return enums.UnmarshalText(i, text, "Delims") }
+
+var _FillMethodsValues = []FillMethods{0, 1, 2, 3}
+
+// FillMethodsN is the highest valid value for type FillMethods, plus one.
+const FillMethodsN FillMethods = 4
+
+var _FillMethodsValueMap = map[string]FillMethods{`ForwardFill`: 0, `BackwardFill`: 1, `ConstantFill`: 2, `MeanFill`: 3}
+
+var _FillMethodsDescMap = map[FillMethods]string{0: `ForwardFill fills Null values with the last non-Null value before it, in view order. Leading Null values with no prior value remain Null.`, 1: `BackwardFill fills Null values with the next non-Null value after it, in view order. Trailing Null values with no later value remain Null.`, 2: `ConstantFill fills Null values with a given constant value`, 3: `MeanFill fills Null values with the mean of the non-Null values in the view`}
+
+var _FillMethodsMap = map[FillMethods]string{0: `ForwardFill`, 1: `BackwardFill`, 2: `ConstantFill`, 3: `MeanFill`}
+
+// String returns the string representation of this FillMethods value.
+func (i FillMethods) String() string { return enums.String(i, _FillMethodsMap) }
+
+// SetString sets the FillMethods value from its string representation,
+// and returns an error if the string is invalid.
+func (i *FillMethods) SetString(s string) error {
+	return enums.SetString(i, s, _FillMethodsValueMap, "FillMethods")
+}
+
+// Int64 returns the FillMethods value as an int64.
+func (i FillMethods) Int64() int64 { return int64(i) }
+
+// SetInt64 sets the FillMethods value from an int64.
+func (i *FillMethods) SetInt64(in int64) { *i = FillMethods(in) }
+
+// Desc returns the description of the FillMethods value.
+func (i FillMethods) Desc() string { return enums.Desc(i, _FillMethodsDescMap) }
+
+// FillMethodsValues returns all possible values for the type FillMethods.
+func FillMethodsValues() []FillMethods { return _FillMethodsValues }
+
+// Values returns all possible values for the type FillMethods.
+func (i FillMethods) Values() []enums.Enum { return enums.Values(_FillMethodsValues) }
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (i FillMethods) MarshalText() ([]byte, error) { return []byte(i.String()), nil }
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (i *FillMethods) UnmarshalText(text []byte) error {
+	return enums.UnmarshalText(i, text, "FillMethods")
+}
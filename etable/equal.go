@@ -0,0 +1,81 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/emer/etable/v2/etensor"
+)
+
+// Equal returns true if this table has the same schema (column names,
+// types and cell shapes), number of rows, and exactly the same values
+// as the other table.  See CompareTo for a description of the first difference.
+func (dt *Table) Equal(other *Table) bool {
+	return dt.CompareTo(other, 0) == nil
+}
+
+// EqualApprox returns true if this table has the same schema (column names,
+// types and cell shapes), number of rows, and values as the other table,
+// with numerical values equal to within given tolerance.
+// See CompareTo for a description of the first difference.
+func (dt *Table) EqualApprox(other *Table, tol float64) bool {
+	return dt.CompareTo(other, tol) == nil
+}
+
+// CompareTo compares this table to the other table, and returns an error
+// describing the first difference found in the schema (column names,
+// types and cell shapes), number of rows, or cell values, or nil if they
+// are the same.  Numerical values are compared to within given tolerance
+// (use 0 for exact equality), and NaN values are equal to each other.
+// This is useful for reporting differences in tests.
+func (dt *Table) CompareTo(other *Table, tol float64) error {
+	if other == nil {
+		return fmt.Errorf("etable.Table CompareTo: other table is nil")
+	}
+	if dt.NumCols() != other.NumCols() {
+		return fmt.Errorf("etable.Table CompareTo: number of columns: %d != %d", dt.NumCols(), other.NumCols())
+	}
+	if dt.Rows != other.Rows {
+		return fmt.Errorf("etable.Table CompareTo: number of rows: %d != %d", dt.Rows, other.Rows)
+	}
+	for ci, cl := range dt.Cols {
+		nm := dt.ColNames[ci]
+		onm := other.ColNames[ci]
+		if nm != onm {
+			return fmt.Errorf("etable.Table CompareTo: column %d name: %s != %s", ci, nm, onm)
+		}
+		ocl := other.Cols[ci]
+		if cl.DataType() != ocl.DataType() {
+			return fmt.Errorf("etable.Table CompareTo: column %s type: %v != %v", nm, cl.DataType(), ocl.DataType())
+		}
+		if !etensor.EqualInts(cl.Shapes()[1:], ocl.Shapes()[1:]) {
+			return fmt.Errorf("etable.Table CompareTo: column %s cell shape: %v != %v", nm, cl.Shapes()[1:], ocl.Shapes()[1:])
+		}
+		_, csz := cl.RowCellSize()
+		isstr := cl.DataType() == etensor.STRING
+		for row := 0; row < dt.Rows; row++ {
+			for i := 0; i < csz; i++ {
+				idx := row*csz + i
+				if isstr {
+					sv, osv := cl.StringValue1D(idx), ocl.StringValue1D(idx)
+					if sv != osv {
+						return fmt.Errorf("etable.Table CompareTo: column %s row %d cell %d: %q != %q", nm, row, i, sv, osv)
+					}
+					continue
+				}
+				fv, ofv := cl.FloatValue1D(idx), ocl.FloatValue1D(idx)
+				if math.IsNaN(fv) && math.IsNaN(ofv) {
+					continue
+				}
+				if !(math.Abs(fv-ofv) <= tol) {
+					return fmt.Errorf("etable.Table CompareTo: column %s row %d cell %d: %g != %g", nm, row, i, fv, ofv)
+				}
+			}
+		}
+	}
+	return nil
+}
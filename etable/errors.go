@@ -0,0 +1,26 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import "errors"
+
+// Sentinel errors wrapped by the errors returned from the Try methods,
+// so that callers can distinguish the kind of failure using errors.Is,
+// e.g., errors.Is(err, etable.ErrColNotFound).
+var (
+	// ErrColNotFound is returned when a column name is not found in the table.
+	ErrColNotFound = errors.New("column not found")
+
+	// ErrInvalidRow is returned when a row index is out of range for the table.
+	ErrInvalidRow = errors.New("invalid row")
+
+	// ErrColDims is returned when a column does not have the cell
+	// dimensionality required by the method, e.g., a scalar (1D) column
+	// for CellFloatTry, or a tensor column for CellTensorTry.
+	ErrColDims = errors.New("wrong column dimensionality")
+
+	// ErrAggNotFound is returned when aggregation results are not found in the Splits.
+	ErrAggNotFound = errors.New("agg results not found")
+)
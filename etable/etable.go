@@ -7,10 +7,16 @@ package etable
 //go:generate core generate
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/emer/etable/v2/etensor"
 )
@@ -33,6 +39,63 @@ type Table struct { //types:add
 
 	// misc meta data for the table.  We use lower-case key names following the struct tag convention:  name = name of table; desc = description; read-only = gui is read-only; precision = n for precision to write out floats in csv.  For Column-specific data, we look for ColName: prefix, specifically ColName:desc = description of the column contents, which is shown as tooltip in the etview.TableView, and :width for width of a column
 	MetaData map[string]string
+
+	// functions called when the table data changes, registered via OnChange
+	OnChanges []func() `copier:"-" view:"-" json:"-" xml:"-"`
+
+	// mutex for protecting concurrent access to the table data, e.g., when
+	// a training loop adds rows while the GUI reads them.  It is used by the
+	// *Locked methods, and must be used consistently by all readers and writers
+	// (e.g., Mu.RLock around other reads) to be effective.
+	Mu sync.RWMutex `copier:"-" view:"-" json:"-" xml:"-"`
+
+	// version counter incremented by Changed, for caches of derived data
+	version atomic.Uint64
+
+	// deferChanges is set by the *Locked write methods while they hold the
+	// write lock, so that Changed defers the OnChange functions until after
+	// the lock is released, recording them in pendingChange.
+	deferChanges, pendingChange bool
+}
+
+// OnChange registers given function to be called whenever the table
+// data is changed via the Table methods, e.g., AddRows, SetNumRows, and
+// SetCell*.  This allows views (plots, tables) to automatically update.
+// Note that direct changes to the column tensors (e.g., via
+// ColByName(...).SetFloat1D) are not detected, so they must be followed
+// by a Changed call, and functions are called on every change, so they should be cheap
+// (e.g., GoUpdatePlot, which only triggers a render update).
+func (dt *Table) OnChange(fn func()) {
+	dt.OnChanges = append(dt.OnChanges, fn)
+}
+
+// Changed calls the functions registered via OnChange.
+// It is called automatically by the Table methods that change the data or
+// the columns, and MUST be called after making any other changes directly
+// to the column tensors (e.g., via ColByName(...).SetFloat1D), so that
+// caches of derived data (e.g., IndexView.CachedAgg) and views are updated.
+// Within the *Locked write methods, the functions are called after the
+// write lock is released, so they can read the table via the *Locked methods.
+func (dt *Table) Changed() {
+	dt.version.Add(1)
+	if dt.deferChanges {
+		dt.pendingChange = true
+		return
+	}
+	dt.callOnChanges()
+}
+
+// callOnChanges calls the functions registered via OnChange.
+func (dt *Table) callOnChanges() {
+	for _, fn := range dt.OnChanges {
+		fn()
+	}
+}
+
+// Version returns a counter that is incremented on every Changed call,
+// which can be used to invalidate caches of data derived from the table.
+func (dt *Table) Version() uint64 {
+	return dt.version.Load()
 }
 
 // NumRows returns the number of rows (arrow / dframe api)
@@ -48,10 +111,10 @@ func (dt *Table) IsValidRow(row int) bool {
 	return true
 }
 
-// IsValidRowTry returns an error message if the row is not valid.
+// IsValidRowTry returns an error wrapping ErrInvalidRow if the row is not valid.
 func (dt *Table) IsValidRowTry(row int) error {
 	if row < 0 || row >= dt.Rows {
-		return fmt.Errorf("etable.Table Row: %v is not valid for table with Rows: %v\n", row, dt.Rows)
+		return fmt.Errorf("etable.Table Row: %v is not valid for table with Rows: %v: %w", row, dt.Rows, ErrInvalidRow)
 	}
 	return nil
 }
@@ -61,6 +124,17 @@ func (dt *Table) NumCols() int {
 	return len(dt.Cols)
 }
 
+// SizeBytes returns the approximate size in bytes of the data in the table,
+// as the sum of the etensor.Tensor SizeBytes of all the columns,
+// e.g., for reporting dataset memory usage.
+func (dt *Table) SizeBytes() int {
+	n := 0
+	for _, cl := range dt.Cols {
+		n += cl.SizeBytes()
+	}
+	return n
+}
+
 // Col returns the tensor at given column index
 func (dt *Table) Col(i int) etensor.Tensor {
 	return dt.Cols[i]
@@ -96,11 +170,11 @@ func (dt *Table) ColIndex(name string) int {
 }
 
 // ColIndexTry returns the index of the given column name,
-// along with an error if not found.
+// along with an error wrapping ErrColNotFound if not found.
 func (dt *Table) ColIndexTry(name string) (int, error) {
 	i, ok := dt.ColNameMap[name]
 	if !ok {
-		return 0, fmt.Errorf("etable.Table ColIndex: column named: %v not found", name)
+		return 0, fmt.Errorf("etable.Table ColIndex: column named: %v: %w", name, ErrColNotFound)
 	}
 	return i, nil
 }
@@ -137,6 +211,34 @@ func (dt *Table) ColIndexesByNamesTry(names []string) ([]int, error) {
 	return cidx, nil
 }
 
+// ColsByNames returns the tensors at the given column names, in order.
+// Entries are nil if name not found -- see Try version for error message.
+func (dt *Table) ColsByNames(names []string) []etensor.Tensor {
+	nc := len(names)
+	if nc == 0 {
+		return nil
+	}
+	cols := make([]etensor.Tensor, nc)
+	for i, cn := range names {
+		cols[i] = dt.ColByName(cn)
+	}
+	return cols
+}
+
+// ColsByNamesTry returns the tensors at the given column names, in order,
+// along with an error if any not found.
+func (dt *Table) ColsByNamesTry(names []string) ([]etensor.Tensor, error) {
+	cidx, err := dt.ColIndexesByNamesTry(names)
+	if err != nil {
+		return nil, err
+	}
+	cols := make([]etensor.Tensor, len(cidx))
+	for i, ci := range cidx {
+		cols[i] = dt.Cols[ci]
+	}
+	return cols, nil
+}
+
 // ColName returns the name of given column
 func (dt *Table) ColName(i int) string {
 	return dt.ColNames[i]
@@ -158,6 +260,9 @@ func (dt *Table) UpdateColNameMap() {
 // AddCol adds the given tensor as a column to the table.
 // returns error if it is not a RowMajor organized tensor, and automatically
 // adjusts the shape to fit the current number of rows.
+// It does not check for an existing column with the same name: a duplicate
+// name only logs a warning, and ColByName etc then only return the first
+// column with that name -- use AddColTry to get an error instead.
 func (dt *Table) AddCol(tsr etensor.Tensor, name string) error {
 	if !tsr.IsRowMajor() {
 		return fmt.Errorf("tensor must be RowMajor organized")
@@ -167,6 +272,71 @@ func (dt *Table) AddCol(tsr etensor.Tensor, name string) error {
 	dt.UpdateColNameMap()
 	rows := max(1, dt.Rows)
 	tsr.SetNumRows(rows)
+	dt.Changed()
+	return nil
+}
+
+// AddColTry adds the given tensor as a column to the table, as in AddCol,
+// returning an error without adding it if there is already a column with
+// the given name, which would otherwise be ambiguous for ColByName etc.
+func (dt *Table) AddColTry(tsr etensor.Tensor, name string) error {
+	if _, has := dt.ColNameMap[name]; has {
+		return fmt.Errorf("etable.Table.AddColTry: column named: %q already exists", name)
+	}
+	return dt.AddCol(tsr, name)
+}
+
+// DuplicateColNames returns an error listing any column names that are
+// used by more than one column, e.g., from a CSV file header, for which
+// ColByName etc only return the first column with that name.
+func (dt *Table) DuplicateColNames() error {
+	var dups []string
+	seen := make(map[string]bool, len(dt.ColNames))
+	for _, nm := range dt.ColNames {
+		if seen[nm] && !slices.Contains(dups, nm) {
+			dups = append(dups, nm)
+		}
+		seen[nm] = true
+	}
+	if len(dups) == 0 {
+		return nil
+	}
+	return fmt.Errorf("etable.Table: duplicate column names: %v -- only the first column with each name can be accessed by name", dups)
+}
+
+// ConvertCol replaces the column of given name with a new column of the
+// given data type, with the same name, cell shape, dimension names and
+// meta data, copying the values using CopyFrom, which converts them to
+// the new type.  Returns an error if the column is not found, the type is
+// not supported, or when converting a String column to a numeric type,
+// if any non-empty value is not a valid number.
+func (dt *Table) ConvertCol(colNm string, newType etensor.Type) error {
+	ci, err := dt.ColIndexTry(colNm)
+	if err != nil {
+		return err
+	}
+	ct := dt.Cols[ci]
+	if ct.DataType() == newType {
+		return nil
+	}
+	nt := etensor.New(newType, ct.Shapes(), nil, ct.DimNames())
+	if nt == nil {
+		return fmt.Errorf("etable.Table.ConvertCol: column: %q data type: %v is not supported", colNm, newType)
+	}
+	if st, ok := ct.(*etensor.String); ok && newType != etensor.STRING {
+		for i, sv := range st.Values {
+			if sv == "" {
+				continue
+			}
+			if _, err := strconv.ParseFloat(sv, 64); err != nil {
+				return fmt.Errorf("etable.Table.ConvertCol: column: %q cannot convert value: %q at index: %d to %v", colNm, sv, i, newType)
+			}
+		}
+	}
+	nt.CopyFrom(ct)
+	nt.CopyMetaData(ct)
+	dt.Cols[ci] = nt
+	dt.Changed()
 	return nil
 }
 
@@ -185,6 +355,7 @@ func (dt *Table) DeleteColIndex(idx int) {
 	dt.Cols = append(dt.Cols[:idx], dt.Cols[idx+1:]...)
 	dt.ColNames = append(dt.ColNames[:idx], dt.ColNames[idx+1:]...)
 	dt.UpdateColNameMap()
+	dt.Changed()
 }
 
 // DeleteAll deletes all columns -- full reset
@@ -193,6 +364,7 @@ func (dt *Table) DeleteAll() {
 	dt.ColNames = nil
 	dt.Rows = 0
 	dt.ColNameMap = nil
+	dt.Changed()
 }
 
 // AddRows adds n rows to each of the columns
@@ -208,6 +380,7 @@ func (dt *Table) SetNumRows(rows int) { //types:add
 	for _, tsr := range dt.Cols {
 		tsr.SetNumRows(rows)
 	}
+	dt.Changed()
 }
 
 // SetFromSchema configures table from given Schema.
@@ -215,6 +388,12 @@ func (dt *Table) SetNumRows(rows int) { //types:add
 // cannot have a null dimension in tensor shape.
 // does not preserve any existing columns / data.
 func (dt *Table) SetFromSchema(sc Schema, rows int) {
+	dt.setFromSchema(sc, rows, false)
+}
+
+// setFromSchema does SetFromSchema, getting the FLOAT64 and FLOAT32
+// columns from the etensor pools if pooled is true, for NewPooled.
+func (dt *Table) setFromSchema(sc Schema, rows int, pooled bool) {
 	nc := len(sc)
 	dt.Cols = make([]etensor.Tensor, nc)
 	dt.ColNames = make([]string, nc)
@@ -223,12 +402,50 @@ func (dt *Table) SetFromSchema(sc Schema, rows int) {
 	for i := range dt.Cols {
 		cl := &sc[i]
 		dt.ColNames[i] = cl.Name
-		sh := append([]int{rows}, cl.CellShape...)
-		dn := append([]string{"row"}, cl.DimNames...)
-		tsr := etensor.New(cl.Type, sh, nil, dn)
-		dt.Cols[i] = tsr
+		dt.Cols[i] = newSchemaCol(cl, rows, pooled)
 	}
 	dt.UpdateColNameMap()
+	dt.Changed()
+}
+
+// setFromSchemaTry does SetFromSchema, adding each column with AddColTry,
+// for loaders configuring columns from names in the data, e.g., a CSV header.
+// Columns with a duplicate name are still added, so the data lines up with
+// the names, but an error listing them is returned.
+func (dt *Table) setFromSchemaTry(sc Schema, rows int) error {
+	dt.Cols = nil
+	dt.ColNames = nil
+	dt.ColNameMap = nil
+	dt.Rows = rows // can be 0
+	var errs []error
+	for i := range sc {
+		cl := &sc[i]
+		tsr := newSchemaCol(cl, max(1, rows), false)
+		if err := dt.AddColTry(tsr, cl.Name); err != nil {
+			errs = append(errs, err)
+			dt.AddCol(tsr, cl.Name)
+		}
+	}
+	dt.Changed()
+	return errors.Join(errs...)
+}
+
+// newSchemaCol returns a new column tensor for given schema column,
+// with given number of rows, from the etensor pools if pooled is true.
+func newSchemaCol(cl *Column, rows int, pooled bool) etensor.Tensor {
+	sh := append([]int{rows}, cl.CellShape...)
+	dn := append([]string{"row"}, cl.DimNames...)
+	var tsr etensor.Tensor
+	if pooled {
+		tsr = newPooledCol(cl.Type, sh, dn)
+	} else {
+		tsr = etensor.New(cl.Type, sh, nil, dn)
+	}
+	if tsr == nil { // no tensor implementation for this type
+		slog.Error("etable.Table SetFromSchema: unsupported column type, using FLOAT64", "name", cl.Name, "type", cl.Type)
+		tsr = etensor.NewFloat64(sh, nil, dn)
+	}
+	return tsr
 }
 
 func NewTable(name string) *Table {
@@ -276,6 +493,71 @@ func (dt *Table) Clone() *Table {
 	return cp
 }
 
+// Head returns a new table containing a copy of the first n rows of this
+// table (or all rows if there are fewer than n), including table and column
+// meta data.
+func (dt *Table) Head(n int) *Table {
+	n = max(0, min(n, dt.Rows))
+	return dt.copyRows(0, n)
+}
+
+// Tail returns a new table containing a copy of the last n rows of this
+// table (or all rows if there are fewer than n), including table and column
+// meta data.
+func (dt *Table) Tail(n int) *Table {
+	n = max(0, min(n, dt.Rows))
+	return dt.copyRows(dt.Rows-n, n)
+}
+
+// SortedCopy returns a new table with a copy of this table's rows, stably
+// sorted by the values in given column names (in priority order), using
+// either ascending or descending order for all of the columns, including
+// meta data.  This table is not modified.  Only valid for 1-dimensional
+// columns.  Returns an error if any column name is not found.
+func (dt *Table) SortedCopy(cols []string, ascending bool) (*Table, error) {
+	cis, err := dt.ColIndexesByNamesTry(cols)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewIndexView(dt)
+	ix.SortStableCols(cis, ascending)
+	cp := ix.NewTable()
+	cp.CopyMetaDataFrom(dt)
+	return cp, nil
+}
+
+// SortByCol sorts the rows of this table in place by the values in given
+// column name, using either ascending or descending order, with a stable
+// sort.  This directly rewrites (mutates) the table column data in the new
+// order, via IndexView.ApplyToTable -- see SortedCopy or IndexView for
+// sorting without modifying the table.  Any existing IndexView's on this
+// table will no longer be valid.  Only valid for 1-dimensional columns.
+// Returns an error if column name not found.
+func (dt *Table) SortByCol(colNm string, ascending bool) error {
+	ci, err := dt.ColIndexTry(colNm)
+	if err != nil {
+		return err
+	}
+	ix := NewIndexView(dt)
+	ix.SortStableCol(ci, ascending)
+	ix.ApplyToTable() // calls Changed
+	return nil
+}
+
+// copyRows returns a new table with a copy of n rows starting at st,
+// including the table and column meta data.
+func (dt *Table) copyRows(st, n int) *Table {
+	sc := dt.Schema()
+	cp := New(sc, n)
+	for i, cl := range dt.Cols {
+		_, csz := cl.RowCellSize()
+		cp.Cols[i].CopyCellsFrom(cl, 0, st*csz, n*csz)
+		cp.Cols[i].CopyMetaData(cl)
+	}
+	cp.CopyMetaDataFrom(dt)
+	return cp
+}
+
 // AppendRows appends shared columns in both tables with input table rows
 func (dt *Table) AppendRows(dt2 *Table) {
 	shared := false
@@ -288,10 +570,60 @@ func (dt *Table) AppendRows(dt2 *Table) {
 				dt.AddRows(dt2.NumRows())
 			}
 			for iRow := 0; iRow < dt2.NumRows(); iRow++ {
-				dt.CopyCell(colName, iRow+strow, dt2, colName, iRow)
+				dt.copyCell(colName, iRow+strow, dt2, colName, iRow)
 			}
 		}
 	}
+	if shared {
+		dt.Changed()
+	}
+}
+
+// ReduceCellCol reduces the n-dimensional cell tensor of each row of the
+// srcCol column to a single scalar value, using given aggregation function
+// and initial value (e.g., agg.SumFunc, agg.MaxFunc), which is stored in the
+// 1-dimensional dstCol column.  Null and NaN cell values are skipped.
+// dstCol is added as a new Float64 column if it does not exist, and
+// otherwise must be an existing 1-dimensional numeric column.
+// This is the table-level analog of etensor.Tensor.Agg, e.g., to produce
+// summary values for a line plot.
+func (dt *Table) ReduceCellCol(srcCol, dstCol string, ini float64, fun etensor.AggFunc) error {
+	sc, err := dt.ColByNameTry(srcCol)
+	if err != nil {
+		return err
+	}
+	if sc.NumDims() == 1 || sc.DataType() == etensor.STRING {
+		return fmt.Errorf("etable.Table ReduceCellCol: source column named: %v must be an n-dimensional numeric column", srcCol)
+	}
+	dc, err := dt.ColByNameTry(dstCol)
+	if err != nil {
+		dc = etensor.NewFloat64([]int{max(1, dt.Rows)}, nil, []string{"row"})
+		dt.AddCol(dc, dstCol)
+	} else if dc.NumDims() != 1 || dc.DataType() == etensor.STRING {
+		return fmt.Errorf("etable.Table ReduceCellCol: destination column named: %v must be a 1-dimensional numeric column", dstCol)
+	}
+	for row := 0; row < dt.Rows; row++ {
+		dc.SetFloat1D(row, sc.SubSpace([]int{row}).Agg(ini, fun))
+	}
+	dt.Changed()
+	return nil
+}
+
+// ForEachRow calls given function for each row of the table in order,
+// from 0 to Rows-1 as of the start of the call, e.g., to transform the
+// values in a row using the Cell and SetCell methods.  Iteration stops at
+// the first error returned by the function, which is returned wrapped with
+// the row number: the changes made for the prior rows (and any made in the
+// failing call) are NOT undone, so the table is partially transformed.
+// Iteration also stops if rows are deleted so that the row is no longer valid.
+func (dt *Table) ForEachRow(fun func(row int) error) error {
+	nr := dt.Rows
+	for row := 0; row < nr && row < dt.Rows; row++ {
+		if err := fun(row); err != nil {
+			return fmt.Errorf("etable.Table.ForEachRow: row %d: %w", row, err)
+		}
+	}
+	return nil
 }
 
 // SetMetaData sets given meta-data key to given value, safely creating the
@@ -386,6 +718,32 @@ func (dt *Table) RowsByStringTry(colNm string, str string, contains, ignoreCase
 	return dt.RowsByStringIndex(ci, str, contains, ignoreCase), nil
 }
 
+// RowsByStringRegexpIndex returns the list of rows whose string value
+// in given column index matches given compiled regular expression.
+// Use anchors (^ $) in the expression to match the entire value.
+func (dt *Table) RowsByStringRegexpIndex(colIndex int, re *regexp.Regexp) []int {
+	col := dt.Cols[colIndex]
+	var idxs []int
+	for i := 0; i < dt.Rows; i++ {
+		if re.MatchString(col.StringValue1D(i)) {
+			idxs = append(idxs, i)
+		}
+	}
+	return idxs
+}
+
+// RowsByStringRegexp returns the list of rows whose string value
+// in given column name matches given compiled regular expression.
+// Use anchors (^ $) in the expression to match the entire value.
+// returns error message for invalid column name.
+func (dt *Table) RowsByStringRegexp(colNm string, re *regexp.Regexp) ([]int, error) {
+	ci, err := dt.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return dt.RowsByStringRegexpIndex(ci, re), nil
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  Cell convenience access methods
 
@@ -432,7 +790,7 @@ func (dt *Table) CellFloatTry(colNm string, row int) (float64, error) {
 		return math.NaN(), err
 	}
 	if ct.NumDims() != 1 {
-		return math.NaN(), fmt.Errorf("etable.Table: CellFloatTry called on column named: %v which is not 1-dimensional", colNm)
+		return math.NaN(), fmt.Errorf("etable.Table: CellFloatTry called on column named: %v which is not 1-dimensional: %w", colNm, ErrColDims)
 	}
 	return ct.FloatValue1D(row), nil
 }
@@ -480,7 +838,7 @@ func (dt *Table) CellStringTry(colNm string, row int) (string, error) {
 		return "", err
 	}
 	if ct.NumDims() != 1 {
-		return "", fmt.Errorf("etable.Table: CellStringTry called on column named: %v which is not 1-dimensional", colNm)
+		return "", fmt.Errorf("etable.Table: CellStringTry called on column named: %v which is not 1-dimensional: %w", colNm, ErrColDims)
 	}
 	return ct.StringValue1D(row), nil
 }
@@ -534,7 +892,7 @@ func (dt *Table) CellTensorTry(colNm string, row int) (etensor.Tensor, error) {
 		return nil, err
 	}
 	if ct.NumDims() == 1 {
-		return nil, fmt.Errorf("etable.Table: CellTensorTry called on column named: %v which is 1-dimensional", colNm)
+		return nil, fmt.Errorf("etable.Table: CellTensorTry called on column named: %v which is 1-dimensional: %w", colNm, ErrColDims)
 	}
 	return ct.SubSpaceTry([]int{row})
 }
@@ -578,7 +936,7 @@ func (dt *Table) CellTensorFloat1DTry(colNm string, row int, idx int) (float64,
 		return 0, err
 	}
 	if ct.NumDims() == 1 {
-		return 0, fmt.Errorf("etable.Table: CellTensorFloat1DTry called on column named: %v which is 1-dimensional", colNm)
+		return 0, fmt.Errorf("etable.Table: CellTensorFloat1DTry called on column named: %v which is 1-dimensional: %w", colNm, ErrColDims)
 	}
 	_, sz := ct.RowCellSize()
 	if idx >= sz || idx < 0 {
@@ -602,6 +960,7 @@ func (dt *Table) SetCellFloatIndex(col, row int, val float64) bool {
 		return false
 	}
 	ct.SetFloat1D(row, val)
+	dt.Changed()
 	return true
 }
 
@@ -619,6 +978,7 @@ func (dt *Table) SetCellFloat(colNm string, row int, val float64) bool {
 		return false
 	}
 	ct.SetFloat1D(row, val)
+	dt.Changed()
 	return true
 }
 
@@ -634,9 +994,32 @@ func (dt *Table) SetCellFloatTry(colNm string, row int, val float64) error {
 		return err
 	}
 	if ct.NumDims() != 1 {
-		return fmt.Errorf("etable.Table: SetCellFloatTry called on column named: %v which is not 1-dimensional", colNm)
+		return fmt.Errorf("etable.Table: SetCellFloatTry called on column named: %v which is not 1-dimensional: %w", colNm, ErrColDims)
 	}
 	ct.SetFloat1D(row, val)
+	dt.Changed()
+	return nil
+}
+
+// SetColFromFloats sets all of the values of the given column (by name)
+// from a copy of the given values, which must have one value per cell of
+// the column across all rows (i.e., Rows * cell size), in row-major order,
+// e.g., results computed from the column's Floats.  Returns an error if
+// the column is not found, is a String column, or the length is wrong.
+func (dt *Table) SetColFromFloats(colNm string, vals []float64) error {
+	ct, err := dt.ColByNameTry(colNm)
+	if err != nil {
+		return err
+	}
+	if ct.DataType() == etensor.STRING {
+		return fmt.Errorf("etable.Table: SetColFromFloats called on String column named: %v", colNm)
+	}
+	_, csz := ct.RowCellSize()
+	if n := dt.Rows * csz; len(vals) != n {
+		return fmt.Errorf("etable.Table: SetColFromFloats column named: %v length of vals: %d != rows * cell size: %d", colNm, len(vals), n)
+	}
+	ct.SetFloats(vals)
+	dt.Changed()
 	return nil
 }
 
@@ -651,6 +1034,7 @@ func (dt *Table) SetCellStringIndex(col, row int, val string) bool {
 		return false
 	}
 	ct.SetString1D(row, val)
+	dt.Changed()
 	return true
 }
 
@@ -668,6 +1052,7 @@ func (dt *Table) SetCellString(colNm string, row int, val string) bool {
 		return false
 	}
 	ct.SetString1D(row, val)
+	dt.Changed()
 	return true
 }
 
@@ -683,14 +1068,19 @@ func (dt *Table) SetCellStringTry(colNm string, row int, val string) error {
 		return err
 	}
 	if ct.NumDims() != 1 {
-		return fmt.Errorf("etable.Table: SetCellStringTry called on column named: %v which is not 1-dimensional", colNm)
+		return fmt.Errorf("etable.Table: SetCellStringTry called on column named: %v which is not 1-dimensional: %w", colNm, ErrColDims)
 	}
 	ct.SetString1D(row, val)
+	dt.Changed()
 	return nil
 }
 
 // SetCellTensorIndex sets the tensor value of cell at given column, row index
 // for columns that have n-dimensional tensors.  Returns true if set.
+// IMPORTANT: the shape of val is NOT checked: values are copied in 1D order
+// up to the smaller of the cell size and val.Len(), so a larger val is
+// silently truncated and a smaller one only sets a prefix of the cell.
+// Use SetCellTensorTryStrict to get an error on shape mismatch instead.
 func (dt *Table) SetCellTensorIndex(col, row int, val etensor.Tensor) bool {
 	if !dt.IsValidRow(row) {
 		return false
@@ -708,11 +1098,14 @@ func (dt *Table) SetCellTensorIndex(col, row int, val etensor.Tensor) bool {
 			ct.SetFloat1D(st+j, val.FloatValue1D(j))
 		}
 	}
+	dt.Changed()
 	return true
 }
 
 // SetCellTensor sets the tensor value of cell at given column (by name), row index
 // for columns that have n-dimensional tensors.  Returns true if set.
+// The shape of val is not checked, and it is truncated to the cell size:
+// see SetCellTensorIndex and SetCellTensorTryStrict.
 func (dt *Table) SetCellTensor(colNm string, row int, val etensor.Tensor) bool {
 	if !dt.IsValidRow(row) {
 		return false
@@ -739,6 +1132,27 @@ func (dt *Table) SetCellTensorTry(colNm string, row int, val etensor.Tensor) err
 	return nil
 }
 
+// SetCellTensorTryStrict sets the tensor value of cell at given column (by name),
+// row index for columns that have n-dimensional tensors, requiring that the
+// shape of val exactly matches the cell shape of the column.
+// Returns an error if column not found, row is invalid, or the shapes differ,
+// in which case nothing is set (unlike the lenient SetCellTensor, which truncates).
+func (dt *Table) SetCellTensorTryStrict(colNm string, row int, val etensor.Tensor) error {
+	if err := dt.IsValidRowTry(row); err != nil {
+		return err
+	}
+	ci, err := dt.ColIndexTry(colNm)
+	if err != nil {
+		return err
+	}
+	cshp := dt.Cols[ci].Shapes()[1:]
+	if !etensor.EqualInts(cshp, val.Shapes()) {
+		return fmt.Errorf("etable.Table SetCellTensorTryStrict: column %s cell shape %v does not match value shape %v", colNm, cshp, val.Shapes())
+	}
+	dt.SetCellTensorIndex(ci, row, val)
+	return nil
+}
+
 // SetCellTensorFloat1D sets the tensor cell's float cell value at given 1D index within cell,
 // at given column (by name), row index for columns that have n-dimensional tensors.
 // Returns true if set.
@@ -756,6 +1170,7 @@ func (dt *Table) SetCellTensorFloat1D(colNm string, row int, idx int, val float6
 	}
 	off := row*sz + idx
 	ct.SetFloat1D(off, val)
+	dt.Changed()
 	return true
 }
 
@@ -776,6 +1191,7 @@ func (dt *Table) SetCellTensorFloat1DTry(colNm string, row int, idx int, val flo
 	}
 	off := row*sz + idx
 	ct.SetFloat1D(off, val)
+	dt.Changed()
 	return nil
 }
 
@@ -786,6 +1202,15 @@ func (dt *Table) SetCellTensorFloat1DTry(colNm string, row int, idx int, val flo
 // It is robust to differences in type -- uses destination cell type.
 // Returns error if column names are invalid.
 func (dt *Table) CopyCell(colNm string, row int, cpt *Table, cpColNm string, cpRow int) error {
+	if err := dt.copyCell(colNm, row, cpt, cpColNm, cpRow); err != nil {
+		return err
+	}
+	dt.Changed()
+	return nil
+}
+
+// copyCell does CopyCell without calling Changed, for bulk copies.
+func (dt *Table) copyCell(colNm string, row int, cpt *Table, cpColNm string, cpRow int) error {
 	ct, err := dt.ColByNameTry(colNm)
 	if err != nil {
 		return err
@@ -5,7 +5,14 @@
 package etable
 
 import (
+	"errors"
+	"math"
+	"math/rand"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/emer/etable/v2/etensor"
 )
@@ -38,3 +45,1015 @@ func TestAdd4DCol(t *testing.T) {
 		t.Errorf("Add4DCol: dim 0 len != 16, was: %v\n", col.Dim(3))
 	}
 }
+
+func TestColsByNames(t *testing.T) {
+	dt := New(Schema{
+		{"Input", etensor.FLOAT32, []int{5}, nil},
+		{"Output", etensor.FLOAT32, []int{5}, nil},
+		{"Target", etensor.FLOAT32, []int{5}, nil},
+	}, 2)
+	cols, err := dt.ColsByNamesTry([]string{"Target", "Input"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0] != dt.Cols[2] || cols[1] != dt.Cols[0] {
+		t.Errorf("ColsByNamesTry: columns not returned in requested order")
+	}
+	_, err = dt.ColsByNamesTry([]string{"Input", "Nope"})
+	if err == nil {
+		t.Errorf("ColsByNamesTry: expected error for missing column")
+	}
+	cols = dt.ColsByNames([]string{"Output", "Nope"})
+	if cols[0] != dt.Cols[1] || cols[1] != nil {
+		t.Errorf("ColsByNames: expected nil for missing column")
+	}
+}
+
+func TestApplyToTable(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	vals := []float64{3, 1, 4, 0, 2}
+	for ri, v := range vals {
+		dt.SetCellFloat("Val", ri, v)
+		dt.SetCellString("Name", ri, string(rune('a'+ri)))
+	}
+	dt.Cols[1].SetMetaData("format", "%.2f")
+	ix := NewIndexView(dt)
+	ix.Filter(func(et *Table, row int) bool {
+		return et.CellFloat("Val", row) > 0
+	})
+	ix.SortColName("Val", Ascending)
+	ix.ApplyToTable()
+	if dt.Rows != 4 || ix.Len() != 4 {
+		t.Fatalf("ApplyToTable: rows: %d view len: %d != 4", dt.Rows, ix.Len())
+	}
+	names := []string{"b", "e", "a", "c"}
+	for ri := 0; ri < dt.Rows; ri++ {
+		if v := dt.CellFloat("Val", ri); v != float64(ri+1) {
+			t.Errorf("ApplyToTable: row %d Val: %v != %v", ri, v, ri+1)
+		}
+		if nm := dt.CellString("Name", ri); nm != names[ri] {
+			t.Errorf("ApplyToTable: row %d Name: %v != %v", ri, nm, names[ri])
+		}
+		if ix.Indexes[ri] != ri {
+			t.Errorf("ApplyToTable: indexes not sequential: %v", ix.Indexes)
+		}
+	}
+	if f, _ := dt.Cols[1].MetaData("format"); f != "%.2f" {
+		t.Errorf("ApplyToTable: column meta data not preserved")
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 0)
+	dt.SetCellFloat("Val", 0, 1) // no listeners, invalid row: no-op
+	nchg := 0
+	dt.OnChange(func() { nchg++ })
+	dt.AddRows(2)
+	if nchg != 1 {
+		t.Errorf("OnChange: AddRows changes: %d != 1", nchg)
+	}
+	dt.SetCellFloat("Val", 1, 2)
+	dt.SetCellTensorFloat1D("Vec", 1, 1, 3)
+	if nchg != 3 {
+		t.Errorf("OnChange: SetCell changes: %d != 3", nchg)
+	}
+	dt.SetCellFloat("Val", 5, 2) // invalid row: not changed
+	if nchg != 3 {
+		t.Errorf("OnChange: invalid SetCell should not signal change: %d != 3", nchg)
+	}
+	ver := dt.Version()
+	dt.AddCol(etensor.NewFloat64([]int{2}, nil, nil), "New")
+	dt.DeleteColName("New")
+	if err := dt.CopyCell("Val", 0, dt, "Val", 1); err != nil {
+		t.Fatal(err)
+	}
+	if nchg != 6 || dt.Version() != ver+3 {
+		t.Errorf("OnChange: AddCol, DeleteColName, CopyCell changes: %d != 6", nchg)
+	}
+	dt.AppendRows(dt.Clone()) // AddRows + one change for all the copied cells
+	if nchg != 8 {
+		t.Errorf("OnChange: AppendRows changes: %d != 8", nchg)
+	}
+}
+
+func TestLocked(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 0)
+	n := 100
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < n; i++ {
+			dt.AddRowsLocked(1)
+			dt.SetCellFloatLocked("Val", i, float64(i))
+		}
+		done <- true
+	}()
+	go func() {
+		for i := 0; i < n; i++ {
+			if nr := dt.NumRowsLocked(); nr > 0 {
+				dt.CellFloatLocked("Val", nr-1)
+			}
+		}
+		done <- true
+	}()
+	<-done
+	<-done
+	if dt.Rows != n || dt.CellFloat("Val", n-1) != float64(n-1) {
+		t.Errorf("Locked: rows: %d last val: %v", dt.Rows, dt.CellFloat("Val", n-1))
+	}
+}
+
+func TestLockedOnChange(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 0)
+	var nr int
+	var val float64
+	dt.OnChange(func() {
+		nr = dt.NumRowsLocked()
+		if nr > 0 {
+			val = dt.CellFloatLocked("Val", nr-1)
+		}
+		dt.SnapshotLocked()
+	})
+	done := make(chan bool)
+	go func() {
+		dt.AddRowsLocked(2)
+		dt.SetCellFloatLocked("Val", 1, 3)
+		dt.SetNumRowsLocked(3)
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Locked: OnChange function reading via *Locked methods deadlocked")
+	}
+	if nr != 3 || val != 0 || dt.CellFloatLocked("Val", 1) != 3 {
+		t.Errorf("Locked OnChange: rows: %d last val: %v", nr, val)
+	}
+	dt.SetCellFloat("Val", 2, 1) // not locked: called directly
+	if val != 1 {
+		t.Errorf("OnChange after Locked: last val: %v != 1", val)
+	}
+}
+
+func TestSnapshotLocked(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Act", etensor.FLOAT32, []int{2, 3}, nil},
+	}, 0)
+	n := 100
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < n; i++ {
+			dt.AddRowsLocked(1)
+			dt.SetCellFloatLocked("Val", i, float64(i))
+		}
+		done <- true
+	}()
+	go func() {
+		for i := 0; i < n; i++ {
+			st := dt.SnapshotLocked()
+			for ci, cl := range st.Cols {
+				if cl.Dim(0) != max(1, st.Rows) {
+					t.Errorf("SnapshotLocked col %d: shape %v != rows %d", ci, cl.Shapes(), st.Rows)
+				}
+			}
+		}
+		done <- true
+	}()
+	<-done
+	<-done
+	st := dt.SnapshotLocked()
+	if st.Rows != n || st.CellFloat("Val", n-1) != float64(n-1) || st.ColIndex("Act") != 1 {
+		t.Errorf("SnapshotLocked: rows: %d last val: %v", st.Rows, st.CellFloat("Val", n-1))
+	}
+	st.SetCellFloat("Val", 0, -1)
+	if dt.CellFloat("Val", 0) != 0 {
+		t.Error("SnapshotLocked: snapshot shares values with table")
+	}
+}
+
+func TestFillNull(t *testing.T) {
+	vals := []float64{1, 0, 0, 4, 0, 7}
+	tests := []struct {
+		method FillMethods
+		ex     []float64
+	}{
+		{ForwardFill, []float64{1, 1, 1, 4, 4, 7}},
+		{BackwardFill, []float64{1, 4, 4, 4, 7, 7}},
+		{ConstantFill, []float64{1, -1, -1, 4, -1, 7}},
+		{MeanFill, []float64{1, 4, 4, 4, 4, 7}},
+	}
+	for _, tst := range tests {
+		dt := New(Schema{
+			{"Val", etensor.FLOAT64, nil, nil},
+		}, 6)
+		for ri, v := range vals {
+			dt.SetCellFloat("Val", ri, v)
+			if v == 0 {
+				dt.Cols[0].SetNull1D(ri, true)
+			}
+		}
+		ix := NewIndexView(dt)
+		ver := dt.Version()
+		ix.FillNull(0, tst.method, -1)
+		if dt.Version() == ver {
+			t.Errorf("FillNull %v: Changed not called", tst.method)
+		}
+		for ri, ex := range tst.ex {
+			if v := dt.CellFloat("Val", ri); v != ex {
+				t.Errorf("FillNull %v row %d: %v != %v", tst.method, ri, v, ex)
+			}
+			if dt.Cols[0].IsNull1D(ri) {
+				t.Errorf("FillNull %v row %d: Null not cleared", tst.method, ri)
+			}
+		}
+	}
+
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 6)
+	for ri, v := range vals {
+		dt.SetCellFloat("Val", ri, v)
+		if v == 0 {
+			dt.Cols[0].SetNull1D(ri, true)
+		}
+	}
+	ix := NewIndexView(dt)
+	ix.Indexes = []int{5, 4, 3, 2, 1, 0} // reverse view order
+	ix.FillNull(0, ForwardFill, 0)
+	if v := dt.CellFloat("Val", 4); v != 7 {
+		t.Errorf("FillNull ForwardFill in reverse view order: %v != 7", v)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 2)
+	a.SetCellString("Name", 1, "b")
+	a.SetCellTensorFloat1D("Vec", 1, 1, 0.5)
+	b := a.Clone()
+	if !a.Equal(b) {
+		t.Errorf("Equal: identical tables not equal: %v", a.CompareTo(b, 0))
+	}
+	b.SetCellTensorFloat1D("Vec", 1, 1, 0.501)
+	if a.Equal(b) {
+		t.Errorf("Equal: different tables are equal")
+	}
+	if !a.EqualApprox(b, 0.01) {
+		t.Errorf("EqualApprox: tables not equal within tolerance: %v", a.CompareTo(b, 0.01))
+	}
+	err := a.CompareTo(b, 0)
+	if err == nil || !strings.Contains(err.Error(), "column Vec row 1 cell 1") {
+		t.Errorf("CompareTo: wrong first difference: %v", err)
+	}
+	b.SetCellTensorFloat1D("Vec", 1, 1, 0.5)
+	b.SetCellString("Name", 0, "x")
+	if err := a.CompareTo(b, 0); err == nil || !strings.Contains(err.Error(), "column Name row 0") {
+		t.Errorf("CompareTo: wrong string difference: %v", err)
+	}
+	b.SetCellString("Name", 0, "")
+	b.AddRows(1)
+	if a.Equal(b) {
+		t.Errorf("Equal: tables with different rows are equal")
+	}
+}
+
+func TestSetCellTensorTryStrict(t *testing.T) {
+	dt := New(Schema{
+		{"Vec", etensor.FLOAT32, []int{2, 2}, nil},
+	}, 2)
+	ok := etensor.NewFloat32([]int{2, 2}, nil, nil)
+	ok.SetFloats([]float64{1, 2, 3, 4})
+	if err := dt.SetCellTensorTryStrict("Vec", 1, ok); err != nil {
+		t.Error(err)
+	}
+	if dt.CellTensorFloat1D("Vec", 1, 3) != 4 {
+		t.Errorf("SetCellTensorTryStrict: value not set")
+	}
+
+	long := etensor.NewFloat32([]int{5}, nil, nil)
+	long.SetFloats([]float64{9, 9, 9, 9, 9})
+	if err := dt.SetCellTensorTryStrict("Vec", 0, long); err == nil {
+		t.Error("SetCellTensorTryStrict: expected error for larger value")
+	}
+	flat := etensor.NewFloat32([]int{4}, nil, nil)
+	if err := dt.SetCellTensorTryStrict("Vec", 0, flat); err == nil {
+		t.Error("SetCellTensorTryStrict: expected error for same size, different shape")
+	}
+	if dt.CellTensorFloat1D("Vec", 0, 0) != 0 {
+		t.Errorf("SetCellTensorTryStrict: value set despite shape mismatch")
+	}
+	if err := dt.SetCellTensorTryStrict("Nope", 0, ok); err == nil {
+		t.Error("SetCellTensorTryStrict: expected error for missing column")
+	}
+
+	// lenient version truncates
+	if !dt.SetCellTensor("Vec", 0, long) {
+		t.Error("SetCellTensor: not set")
+	}
+	if dt.CellTensorFloat1D("Vec", 0, 3) != 9 || dt.CellTensorFloat1D("Vec", 1, 0) != 1 {
+		t.Errorf("SetCellTensor: truncation did not stay within cell")
+	}
+	short := etensor.NewFloat32([]int{1}, nil, nil)
+	short.SetFloats([]float64{5})
+	dt.SetCellTensor("Vec", 0, short)
+	if dt.CellTensorFloat1D("Vec", 0, 0) != 5 || dt.CellTensorFloat1D("Vec", 0, 1) != 9 {
+		t.Errorf("SetCellTensor: short value should only set prefix")
+	}
+}
+
+func TestSchemaUnsupportedType(t *testing.T) {
+	dt := New(Schema{
+		{"Half", etensor.FLOAT16, nil, nil},
+		{"Int", etensor.INT, nil, nil},
+	}, 3)
+	if dt.Cols[0] == nil || dt.Cols[0].DataType() != etensor.FLOAT64 {
+		t.Errorf("FLOAT16 column should fall back to FLOAT64")
+	}
+	if dt.Cols[1].DataType() != etensor.INT || dt.Rows != 3 {
+		t.Errorf("INT column: %v rows %d", dt.Cols[1].DataType(), dt.Rows)
+	}
+}
+
+func TestSchemaCompatible(t *testing.T) {
+	sc := Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2, 3}, []string{"Y", "X"}},
+	}
+	dt := New(sc, 2)
+	if ok, msg := dt.Schema().Compatible(sc); !ok || msg != "" {
+		t.Errorf("Compatible equal: %v %q", ok, msg)
+	}
+	if !dt.Schema().Equals(sc) {
+		t.Error("Equals: table schema should equal its source schema")
+	}
+
+	ot := Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Vec", etensor.FLOAT64, []int{2, 3}, []string{"Y", "X"}},
+	}
+	if ok, msg := sc.Compatible(ot); ok || msg != "column 1 (Vec): type FLOAT32 != FLOAT64" {
+		t.Errorf("Compatible type mismatch: %v %q", ok, msg)
+	}
+	if sc.Equals(ot) {
+		t.Error("Equals: type mismatch should not be equal")
+	}
+
+	ot[1].Type = etensor.FLOAT32
+	ot[1].CellShape = []int{3, 2}
+	if ok, msg := sc.Compatible(ot); ok || msg != "column 1 (Vec): cell shape [2 3] != [3 2]" {
+		t.Errorf("Compatible cell shape mismatch: %v %q", ok, msg)
+	}
+
+	ot[1].CellShape = []int{2, 3}
+	ot[1].DimNames = nil
+	if ok, _ := sc.Compatible(ot); !ok {
+		t.Error("Compatible: dim names should not matter")
+	}
+	if sc.Equals(ot) {
+		t.Error("Equals: dim names should matter")
+	}
+	if ok, msg := sc.Compatible(ot[:1]); ok || msg != "number of columns: 2 != 1" {
+		t.Errorf("Compatible number of columns: %v %q", ok, msg)
+	}
+}
+
+func TestNewSchema(t *testing.T) {
+	sc := NewSchema().AddString("Name").AddInt("Trial").AddFloat64("Act", []int{10, 10}).
+		AddFloat32("Pools", []int{2, 2, 3, 3}).AddCol("Vec", etensor.INT64, []int{3}, "Dim")
+	ex := Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Trial", etensor.INT, nil, nil},
+		{"Act", etensor.FLOAT64, []int{10, 10}, []string{"Y", "X"}},
+		{"Pools", etensor.FLOAT32, []int{2, 2, 3, 3}, []string{"PoolY", "PoolX", "NeurY", "NeurX"}},
+		{"Vec", etensor.INT64, []int{3}, []string{"Dim"}},
+	}
+	if !sc.Equals(ex) {
+		t.Errorf("NewSchema: %v != %v", sc, ex)
+	}
+	dt := New(sc, 2)
+	if dt.ColByName("Act").Len() != 200 || dt.ColByName("Act").DimName(2) != "X" {
+		t.Errorf("NewSchema table: %v %v", dt.ColByName("Act").Shapes(), dt.ColByName("Act").DimNames())
+	}
+}
+
+func TestHeadTail(t *testing.T) {
+	dt := New(Schema{
+		{"Idx", etensor.INT, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 10)
+	for i := 0; i < 10; i++ {
+		dt.SetCellFloat("Idx", i, float64(i))
+		dt.SetCellTensorFloat1D("Vec", i, 1, float64(10*i))
+	}
+	dt.ColByName("Vec").SetMetaData("grid-fill", "0.5")
+	hd := dt.Head(3)
+	if hd.Rows != 3 {
+		t.Fatalf("Head(3) rows: %d", hd.Rows)
+	}
+	if v, _ := hd.ColByName("Vec").MetaData("grid-fill"); v != "0.5" {
+		t.Errorf("Head(3) column meta data not copied: %q", v)
+	}
+	for i := 0; i < 3; i++ {
+		if hd.CellFloat("Idx", i) != float64(i) || hd.CellTensorFloat1D("Vec", i, 1) != float64(10*i) {
+			t.Errorf("Head(3) row %d wrong values", i)
+		}
+	}
+	tl := dt.Tail(2)
+	if tl.Rows != 2 || tl.CellFloat("Idx", 0) != 8 || tl.CellTensorFloat1D("Vec", 1, 1) != 90 {
+		t.Errorf("Tail(2) wrong rows / values")
+	}
+	if v, _ := tl.ColByName("Vec").MetaData("grid-fill"); v != "0.5" {
+		t.Errorf("Tail(2) column meta data not copied: %q", v)
+	}
+	if dt.Head(20).Rows != 10 || dt.Tail(-1).Rows != 0 {
+		t.Errorf("Head / Tail not clamped")
+	}
+}
+
+func TestIndexViewHeadTail(t *testing.T) {
+	dt := New(Schema{
+		{"Idx", etensor.INT, nil, nil},
+	}, 10)
+	for i := 0; i < 10; i++ {
+		dt.SetCellFloat("Idx", i, float64(i))
+	}
+	ix := NewIndexView(dt)
+	ix.SortColName("Idx", Descending)
+	hv := ix.HeadView(3)
+	if !slices.Equal(hv.Indexes, []int{9, 8, 7}) || ix.Len() != 10 {
+		t.Errorf("HeadView(3): %v, orig len %d", hv.Indexes, ix.Len())
+	}
+	tv := ix.TailView(2)
+	if !slices.Equal(tv.Indexes, []int{1, 0}) {
+		t.Errorf("TailView(2): %v", tv.Indexes)
+	}
+	ix.Tail(4)
+	if !slices.Equal(ix.Indexes, []int{3, 2, 1, 0}) {
+		t.Errorf("Tail(4): %v", ix.Indexes)
+	}
+	ix.Head(20)
+	if ix.Len() != 4 {
+		t.Errorf("Head(20) not clamped: %d", ix.Len())
+	}
+	ix.Head(-1)
+	if ix.Len() != 0 {
+		t.Errorf("Head(-1): %d", ix.Len())
+	}
+}
+
+func TestRowsByStringRegexp(t *testing.T) {
+	dt := New(Schema{
+		{"Code", etensor.STRING, nil, nil},
+	}, 5)
+	for i, c := range []string{"AB-12", "XAB-3", "AB-x", "ab-45", "CD-12"} {
+		dt.SetCellString("Code", i, c)
+	}
+	rows, err := dt.RowsByStringRegexp("Code", regexp.MustCompile(`^AB-\d+$`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(rows, []int{0}) {
+		t.Errorf("anchored: %v != [0]", rows)
+	}
+	rows, _ = dt.RowsByStringRegexp("Code", regexp.MustCompile(`AB-`))
+	if !slices.Equal(rows, []int{0, 1, 2}) {
+		t.Errorf("unanchored: %v != [0 1 2]", rows)
+	}
+	if _, err := dt.RowsByStringRegexp("Nope", regexp.MustCompile(`x`)); err == nil {
+		t.Error("expected error for missing column")
+	}
+	ix := NewIndexView(dt)
+	ix.Indexes = []int{4, 3, 0}
+	idxs, _ := ix.RowsByStringRegexp("Code", regexp.MustCompile(`-12$`))
+	if !slices.Equal(idxs, []int{0, 2}) {
+		t.Errorf("IndexView: %v != [0 2]", idxs)
+	}
+}
+
+func TestSortedCopy(t *testing.T) {
+	dt := New(Schema{
+		{"Grp", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	grps := []string{"b", "a", "b", "a", "a"}
+	for i, g := range grps {
+		dt.SetCellString("Grp", i, g)
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	st, err := dt.SortedCopy([]string{"Grp"}, Ascending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stable: original order preserved within groups
+	exp := []float64{1, 3, 4, 0, 2}
+	for i, e := range exp {
+		if st.CellFloat("Val", i) != e {
+			t.Errorf("SortedCopy row %d: %v != %v", i, st.CellFloat("Val", i), e)
+		}
+	}
+	if dt.CellString("Grp", 0) != "b" || dt.CellFloat("Val", 0) != 0 {
+		t.Error("SortedCopy modified the original table")
+	}
+	st, _ = dt.SortedCopy([]string{"Grp", "Val"}, Descending)
+	if st.CellFloat("Val", 0) != 2 || st.CellFloat("Val", 4) != 1 {
+		t.Errorf("SortedCopy descending: first %v last %v", st.CellFloat("Val", 0), st.CellFloat("Val", 4))
+	}
+	if _, err := dt.SortedCopy([]string{"Nope"}, Ascending); err == nil {
+		t.Error("SortedCopy: expected error for unknown column")
+	}
+}
+
+func TestSortByCol(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 4)
+	vals := []float64{3, 1, 4, 2}
+	for i, v := range vals {
+		dt.SetCellString("Name", i, string(rune('a'+i)))
+		dt.SetCellFloat("Val", i, v)
+		dt.SetCellTensorFloat1D("Vec", i, 1, 10*v)
+	}
+	if err := dt.SortByCol("Val", Ascending); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{"b", "d", "a", "c"}
+	for i, nm := range names {
+		if dt.CellString("Name", i) != nm || dt.CellFloat("Val", i) != float64(i+1) || dt.CellTensorFloat1D("Vec", i, 1) != float64(10*(i+1)) {
+			t.Errorf("SortByCol row %d: %v %v %v", i, dt.CellString("Name", i), dt.CellFloat("Val", i), dt.CellTensorFloat1D("Vec", i, 1))
+		}
+	}
+	if err := dt.SortByCol("Nope", Ascending); err == nil {
+		t.Error("SortByCol: expected error for unknown column")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	dt := New(Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	for i, c := range []string{"A", "B", "A", "C", "A"} {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	ix := NewIndexView(dt)
+	ix.Indexes = []int{4, 3, 2, 1, 0}
+	gps, err := ix.GroupBy("Cond")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gps) != 3 {
+		t.Fatalf("GroupBy: %d groups != 3", len(gps))
+	}
+	if !slices.Equal(gps["A"].Indexes, []int{4, 2, 0}) || !slices.Equal(gps["C"].Indexes, []int{3}) {
+		t.Errorf("GroupBy: A %v C %v", gps["A"].Indexes, gps["C"].Indexes)
+	}
+	for _, gp := range gps {
+		if gp.Table != dt {
+			t.Error("GroupBy: groups must share the same Table")
+		}
+	}
+	if _, err := ix.GroupBy("Nope"); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
+
+func TestSampleWeighted(t *testing.T) {
+	dt := New(Schema{
+		{"W", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for i, w := range []float64{1, 0, 3, math.NaN()} {
+		dt.SetCellFloat("W", i, w)
+	}
+	ix := NewIndexView(dt)
+	if err := ix.SampleWeighted(4000, "W", 1); err != nil {
+		t.Fatal(err)
+	}
+	if ix.Len() != 4000 {
+		t.Fatalf("SampleWeighted: len %d != 4000", ix.Len())
+	}
+	counts := make([]int, dt.Rows)
+	for _, r := range ix.Indexes {
+		counts[r]++
+	}
+	if counts[1] != 0 || counts[3] != 0 {
+		t.Errorf("SampleWeighted: zero / NaN weight rows sampled: %v", counts)
+	}
+	if ratio := float64(counts[2]) / float64(counts[0]); ratio < 2.5 || ratio > 3.5 {
+		t.Errorf("SampleWeighted: ratio %v not near 3: %v", ratio, counts)
+	}
+
+	ix2 := NewIndexView(dt)
+	ix2.SampleWeighted(4000, "W", 1)
+	if !slices.Equal(ix.Indexes, ix2.Indexes) {
+		t.Error("SampleWeighted: same seed should give same sample")
+	}
+
+	dt.SetCellFloat("W", 1, -1)
+	ix = NewIndexView(dt)
+	if err := ix.SampleWeighted(10, "W", 1); err == nil || ix.Len() != 4 {
+		t.Error("SampleWeighted: expected error and unchanged indexes for negative weight")
+	}
+	if err := ix.SampleWeighted(10, "Nope", 1); err == nil {
+		t.Error("expected error for missing column")
+	}
+	dt.SetCellFloat("W", 1, 1)
+	if err := ix.SampleWeighted(-1, "W", 1); err == nil || ix.Len() != 4 {
+		t.Error("SampleWeighted: expected error and unchanged indexes for negative n")
+	}
+	dt.AddCol(etensor.NewFloat64([]int{4, 2}, nil, nil), "V")
+	if err := ix.SampleWeighted(10, "V", 1); !errors.Is(err, ErrColDims) {
+		t.Errorf("SampleWeighted: error: %v is not ErrColDims for n-dimensional column", err)
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"W", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for i, w := range []float64{1, 10, math.NaN(), 1} {
+		dt.SetCellFloat("W", i, w)
+	}
+	ix := NewIndexView(dt)
+	ix.Indexes = []int{3, 2, 1} // view indexes are returned
+	vidxs, err := ix.WeightedSample(2000, 1, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make([]int, ix.Len())
+	for _, vi := range vidxs {
+		counts[vi]++
+	}
+	if counts[1] != 0 || counts[2] < 5*counts[0] {
+		t.Errorf("WeightedSample: high weight row not sampled more often: %v", counts)
+	}
+	if _, err := ix.WeightedSample(10, 5, nil); err == nil {
+		t.Error("WeightedSample: expected error for invalid column index")
+	}
+	dt.SetCellFloat("W", 1, 0)
+	dt.SetCellFloat("W", 3, 0)
+	if _, err := ix.WeightedSample(10, 1, nil); err == nil {
+		t.Error("WeightedSample: expected error for all zero weights")
+	}
+}
+
+func TestReduceCellCol(t *testing.T) {
+	dt := New(Schema{
+		{"Act", etensor.FLOAT32, []int{4, 4}, nil},
+	}, 3)
+	act := dt.Cols[0].(*etensor.Float32)
+	for i := range act.Values {
+		act.Values[i] = float32(i % 7)
+	}
+	mean := func(idx int, val float64, ag float64) float64 { // running mean
+		return ag + (val-ag)/float64(idx+1)
+	}
+	if err := dt.ReduceCellCol("Act", "ActMean", 0, mean); err != nil {
+		t.Fatal(err)
+	}
+	mc := dt.ColByName("ActMean")
+	if mc == nil || mc.NumDims() != 1 || mc.Len() != 3 {
+		t.Fatal("ReduceCellCol: ActMean column not added properly")
+	}
+	for row := 0; row < dt.Rows; row++ {
+		sum := 0.0
+		for i := 0; i < 16; i++ {
+			sum += float64(act.Values[row*16+i])
+		}
+		if got := mc.FloatValue1D(row); math.Abs(got-sum/16) > 1e-9 {
+			t.Errorf("ReduceCellCol row %d: %v != %v", row, got, sum/16)
+		}
+	}
+	// reuses existing column
+	max := func(idx int, val float64, ag float64) float64 { return math.Max(val, ag) }
+	if err := dt.ReduceCellCol("Act", "ActMean", -math.MaxFloat64, max); err != nil || dt.NumCols() != 2 {
+		t.Errorf("ReduceCellCol into existing column: %v", err)
+	}
+	if mc.FloatValue1D(0) != 6 {
+		t.Errorf("ReduceCellCol max: %v != 6", mc.FloatValue1D(0))
+	}
+	if err := dt.ReduceCellCol("ActMean", "X", 0, max); err == nil {
+		t.Error("expected error for 1-dimensional source column")
+	}
+	if err := dt.ReduceCellCol("Act", "Act", 0, max); err == nil {
+		t.Error("expected error for n-dimensional destination column")
+	}
+}
+
+func TestForEachRow(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 4)
+	for i := 0; i < dt.Rows; i++ {
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	err := dt.ForEachRow(func(row int) error {
+		dt.SetCellFloat("Val", row, 2*dt.CellFloat("Val", row))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < dt.Rows; i++ {
+		if v := dt.CellFloat("Val", i); v != float64(2*i) {
+			t.Errorf("ForEachRow double row %d: %v != %v", i, v, 2*i)
+		}
+	}
+
+	errStop := errors.New("stop")
+	err = dt.ForEachRow(func(row int) error {
+		if row == 2 {
+			return errStop
+		}
+		dt.SetCellFloat("Val", row, -1)
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("ForEachRow error: %v", err)
+	}
+	for i, exp := range []float64{-1, -1, 4, 6} { // partially applied
+		if v := dt.CellFloat("Val", i); v != exp {
+			t.Errorf("ForEachRow partial row %d: %v != %v", i, v, exp)
+		}
+	}
+}
+
+func TestSizeBytes(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Act", etensor.FLOAT32, []int{2, 2}, nil},
+		{"Flag", etensor.BOOL, nil, nil},
+	}, 10)
+	dt.SetCellString("Name", 0, "abcd")
+	exp := dt.Cols[0].SizeBytes() + 10*8 + 10*4*4 + dt.Cols[3].SizeBytes()
+	if sz := dt.SizeBytes(); sz != exp {
+		t.Errorf("SizeBytes: %d != %d", sz, exp)
+	}
+	if sz := dt.Cols[1].SizeBytes(); sz != 80 {
+		t.Errorf("Float64 SizeBytes: %d != 80", sz)
+	}
+	emp := dt.Cols[0].SizeBytes()
+	dt.SetCellString("Name", 1, "efgh")
+	if sz := dt.Cols[0].SizeBytes(); sz != emp+4 {
+		t.Errorf("String SizeBytes should include contents: %d != %d", sz, emp+4)
+	}
+}
+
+func TestIndexViewColRange(t *testing.T) {
+	dt := New(Schema{
+		{"Val", etensor.FLOAT64, nil, nil},
+		{"Vec", etensor.FLOAT32, []int{2}, nil},
+	}, 6)
+	for i := 0; i < 6; i++ {
+		dt.SetCellFloat("Val", i, float64(i-2))
+		dt.SetCellTensorFloat1D("Vec", i, 1, float64(10*i))
+	}
+	dt.SetCellFloat("Val", 0, math.NaN())
+	dt.ColByName("Val").SetNull1D(5, true)
+	ix := NewIndexView(dt)
+	if mn, mx := ix.ColRange(0, 0); mn != -1 || mx != 2 {
+		t.Errorf("ColRange 1D: %g, %g != -1, 2", mn, mx)
+	}
+	ix.Indexes = []int{4, 2}
+	if mn, mx := ix.ColRange(1, 1); mn != 20 || mx != 40 {
+		t.Errorf("ColRange cell: %g, %g != 20, 40", mn, mx)
+	}
+	if mn, mx := ix.ColRange(1, 2); mn != 0 || mx != 0 {
+		t.Errorf("ColRange out of range cell: %g, %g", mn, mx)
+	}
+	ix.Indexes = []int{0, 5}
+	if mn, mx := ix.ColRange(0, 0); mn != 0 || mx != 0 {
+		t.Errorf("ColRange all missing: %g, %g", mn, mx)
+	}
+}
+
+func TestAddColTry(t *testing.T) {
+	dt := New(NewSchema().AddString("Name"), 3)
+	if err := dt.AddColTry(etensor.NewFloat64([]int{1}, nil, nil), "Value"); err != nil {
+		t.Fatal(err)
+	}
+	if dt.NumCols() != 2 || dt.ColByName("Value").Len() != 3 {
+		t.Errorf("AddColTry: cols %d", dt.NumCols())
+	}
+	if err := dt.AddColTry(etensor.NewFloat64([]int{1}, nil, nil), "Value"); err == nil {
+		t.Error("AddColTry: expected error for duplicate name")
+	}
+	if dt.NumCols() != 2 || dt.DuplicateColNames() != nil {
+		t.Errorf("AddColTry: duplicate column should not be added: cols %d", dt.NumCols())
+	}
+	dt.AddCol(etensor.NewFloat64([]int{1}, nil, nil), "Name") // lenient
+	if dt.NumCols() != 3 || dt.DuplicateColNames() == nil {
+		t.Errorf("AddCol: duplicate column should be added and reported: cols %d", dt.NumCols())
+	}
+}
+
+func TestSetColFromFloats(t *testing.T) {
+	dt := New(NewSchema().AddString("Name").AddFloat32("Vec", []int{2}), 3)
+	vals := []float64{1, 2, 3, 4, 5, 6}
+	if err := dt.SetColFromFloats("Vec", vals); err != nil {
+		t.Fatal(err)
+	}
+	if v := dt.CellTensorFloat1D("Vec", 2, 1); v != 6 {
+		t.Errorf("SetColFromFloats: %v != 6", v)
+	}
+	if err := dt.SetColFromFloats("Vec", vals[:5]); err == nil {
+		t.Error("SetColFromFloats: expected error for length mismatch")
+	}
+	if err := dt.SetColFromFloats("Name", vals[:3]); err == nil {
+		t.Error("SetColFromFloats: expected error for String column")
+	}
+	if err := dt.SetColFromFloats("Missing", vals); err == nil {
+		t.Error("SetColFromFloats: expected error for missing column")
+	}
+}
+
+func TestConvertCol(t *testing.T) {
+	dt := New(NewSchema().AddInt("Int").AddFloat64("Flt", nil).AddString("Str"), 3)
+	for i := 0; i < 3; i++ {
+		dt.SetCellFloat("Int", i, float64(i+1))
+		dt.SetCellFloat("Flt", i, float64(i)+0.5)
+		dt.SetCellString("Str", i, "abc")
+	}
+	if err := dt.ConvertCol("Int", etensor.FLOAT64); err != nil {
+		t.Fatal(err)
+	}
+	ic := dt.ColByName("Int")
+	if ic == nil || ic.DataType() != etensor.FLOAT64 {
+		t.Fatalf("ConvertCol: Int column not converted to FLOAT64: %v", ic)
+	}
+	if v := dt.CellFloat("Int", 2); v != 3 {
+		t.Errorf("ConvertCol: Int value %v != 3", v)
+	}
+	if err := dt.ConvertCol("Flt", etensor.STRING); err != nil {
+		t.Fatal(err)
+	}
+	fc := dt.ColByName("Flt")
+	if fc == nil || fc.DataType() != etensor.STRING {
+		t.Fatalf("ConvertCol: Flt column not converted to STRING: %v", fc)
+	}
+	if v := dt.CellString("Flt", 1); v != "1.5" {
+		t.Errorf("ConvertCol: Flt value %q != 1.5", v)
+	}
+	if err := dt.ConvertCol("Str", etensor.FLOAT64); err == nil {
+		t.Error("ConvertCol: expected error converting non-numeric strings")
+	}
+	if err := dt.ConvertCol("Missing", etensor.FLOAT64); err == nil {
+		t.Error("ConvertCol: expected error for missing column")
+	}
+}
+
+func TestScanRow(t *testing.T) {
+	type trial struct {
+		Subject string  `etable:"Subject"`
+		Trial   int     `etable:"Trial"`
+		RT      float64 `etable:"RT"`
+		Acc     float32 `etable:"Acc"`
+		Note    string
+	}
+	dt := New(NewSchema().AddString("Subject").AddInt("Trial").AddFloat64("RT", nil).AddFloat32("Acc", nil), 2)
+	dt.SetCellString("Subject", 1, "s02")
+	dt.SetCellFloat("Trial", 1, 7)
+	dt.SetCellFloat("RT", 1, 0.532)
+	dt.SetCellFloat("Acc", 1, 1)
+
+	var tr trial
+	if err := dt.ScanRow(1, &tr); err != nil {
+		t.Fatal(err)
+	}
+	if tr.Subject != "s02" || tr.Trial != 7 || tr.RT != 0.532 || tr.Acc != 1 {
+		t.Errorf("ScanRow: %+v", tr)
+	}
+
+	tr = trial{Subject: "s01", Trial: 3, RT: 0.25, Acc: 0}
+	if err := dt.SetRow(0, tr); err != nil {
+		t.Fatal(err)
+	}
+	if dt.CellString("Subject", 0) != "s01" || dt.CellFloat("Trial", 0) != 3 || dt.CellFloat("RT", 0) != 0.25 {
+		t.Errorf("SetRow: %v %v %v", dt.CellString("Subject", 0), dt.CellFloat("Trial", 0), dt.CellFloat("RT", 0))
+	}
+
+	var bad struct {
+		Subject float64 `etable:"Subject"`
+	}
+	if err := dt.ScanRow(0, &bad); err == nil {
+		t.Error("ScanRow: expected error for float field with String column")
+	}
+	if err := dt.ScanRow(0, tr); err == nil {
+		t.Error("ScanRow: expected error for non-pointer dst")
+	}
+	if err := dt.ScanRow(5, &tr); err == nil {
+		t.Error("ScanRow: expected error for invalid row")
+	}
+}
+
+func TestTryErrors(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2, 2}, nil},
+	}, 2)
+	if _, err := dt.ColIndexTry("Bogus"); !errors.Is(err, ErrColNotFound) {
+		t.Errorf("ColIndexTry: error: %v is not ErrColNotFound", err)
+	}
+	if _, err := dt.CellFloatTry("Bogus", 0); !errors.Is(err, ErrColNotFound) {
+		t.Errorf("CellFloatTry: error: %v is not ErrColNotFound", err)
+	}
+	if _, err := dt.CellFloatTry("Name", 5); !errors.Is(err, ErrInvalidRow) {
+		t.Errorf("CellFloatTry: error: %v is not ErrInvalidRow", err)
+	}
+	_, err := dt.CellFloatTry("Input", 0)
+	if !errors.Is(err, ErrColDims) || errors.Is(err, ErrColNotFound) {
+		t.Errorf("CellFloatTry: error: %v is not ErrColDims", err)
+	}
+	if _, err := dt.CellTensorTry("Name", 0); !errors.Is(err, ErrColDims) {
+		t.Errorf("CellTensorTry: error: %v is not ErrColDims", err)
+	}
+	spl := &Splits{}
+	if _, err := spl.AggByNameTry("Mean"); !errors.Is(err, ErrAggNotFound) {
+		t.Errorf("AggByNameTry: error: %v is not ErrAggNotFound", err)
+	}
+}
+
+func TestAggsToTableN(t *testing.T) {
+	dt := New(Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	conds := []string{"A", "B", "A", "B", "A"}
+	for i, c := range conds {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	spl := &Splits{}
+	spl.SetLevels("Cond")
+	spl.New(dt, []string{"A"}, 0, 2, 4)
+	spl.New(dt, []string{"B"}, 1, 3)
+	ag := spl.AddAgg("Mean", dt.ColIndex("Val"))
+	ag.Aggs = [][]float64{{2}, {2}}
+	at := spl.AggsToTableN(AddAggName)
+	if at.NumCols() != 3 || at.ColNames[2] != "N" {
+		t.Fatalf("AggsToTableN cols: %v", at.ColNames)
+	}
+	for i, sp := range spl.Splits {
+		if n := int(at.CellFloat("N", i)); n != len(sp.Indexes) {
+			t.Errorf("AggsToTableN %s: N %d != %d", at.CellString("Cond", i), n, len(sp.Indexes))
+		}
+	}
+	if at.CellString("Cond", 0) != "A" || at.CellFloat("N", 0) != 3 || at.CellFloat("Val:Mean", 0) != 2 {
+		t.Errorf("AggsToTableN A: N %v Mean %v", at.CellFloat("N", 0), at.CellFloat("Val:Mean", 0))
+	}
+	if (&Splits{}).AggsToTableN(AddAggName) != nil {
+		t.Error("AggsToTableN: expected nil for no splits")
+	}
+}
+
+func TestNewPooled(t *testing.T) {
+	sc := Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2, 3}, []string{"Y", "X"}},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}
+	dt := NewPooled(sc, 4)
+	if dt.Rows != 4 || dt.NumCols() != 3 || dt.ColIndex("Err") != 2 {
+		t.Fatalf("NewPooled: rows: %d cols: %d", dt.Rows, dt.NumCols())
+	}
+	if err := dt.CompareTo(New(sc, 4), 0); err != nil {
+		t.Errorf("NewPooled: %v", err)
+	}
+	in := dt.ColByName("Input")
+	if !slices.Equal(in.DimNames(), []string{"row", "Y", "X"}) {
+		t.Errorf("NewPooled: dim names: %v", in.DimNames())
+	}
+	in.SetFloat1D(5, 1)
+	dt.SetCellFloat("Err", 1, 2)
+	dt.ColByName("Err").SetNull1D(2, true)
+	dt.Recycle()
+	if dt.Rows != 0 || dt.NumCols() != 0 || dt.ColIndex("Err") != -1 {
+		t.Errorf("Recycle: table not reset: rows: %d cols: %d", dt.Rows, dt.NumCols())
+	}
+
+	// whether or not the recycled columns are re-used, values must be zero
+	nt := NewPooled(sc, 4)
+	for ci, cl := range nt.Cols {
+		for i := 0; i < cl.Len(); i++ {
+			if cl.IsNull1D(i) || (cl.DataType() != etensor.STRING && cl.FloatValue1D(i) != 0) {
+				t.Errorf("NewPooled after Recycle: col: %s index: %d not reset", nt.ColNames[ci], i)
+			}
+		}
+	}
+}
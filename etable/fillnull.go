@@ -0,0 +1,95 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+// FillMethods are the methods for filling in Null values, used in FillNull
+type FillMethods int32 //enums:enum
+
+const (
+	// ForwardFill fills Null values with the last non-Null value before it, in view order.
+	// Leading Null values with no prior value remain Null.
+	ForwardFill FillMethods = iota
+
+	// BackwardFill fills Null values with the next non-Null value after it, in view order.
+	// Trailing Null values with no later value remain Null.
+	BackwardFill
+
+	// ConstantFill fills Null values with a given constant value
+	ConstantFill
+
+	// MeanFill fills Null values with the mean of the non-Null values in the view
+	MeanFill
+)
+
+// FillNull fills in the Null values of given column, in the current order
+// of the indexes in this view, using given fill method (constant is only
+// used for ConstantFill).  The imputed values are written into the
+// underlying table column, and the Null flags are cleared, and
+// Table.Changed is called.
+// For columns with n-dimensional cells, each cell element is filled
+// independently.  String columns are not supported and are left as-is.
+func (ix *IndexView) FillNull(colIndex int, method FillMethods, constant float64) {
+	cl := ix.Table.Cols[colIndex]
+	if !cl.DataType().IsNumeric() {
+		return
+	}
+	_, csz := cl.RowCellSize()
+	nr := len(ix.Indexes)
+	for ci := 0; ci < csz; ci++ {
+		switch method {
+		case ForwardFill:
+			has := false
+			last := 0.0
+			for i := 0; i < nr; i++ {
+				idx := ix.Indexes[i]*csz + ci
+				if !cl.IsNull1D(idx) {
+					has = true
+					last = cl.FloatValue1D(idx)
+				} else if has {
+					cl.SetFloat1D(idx, last)
+					cl.SetNull1D(idx, false)
+				}
+			}
+		case BackwardFill:
+			has := false
+			next := 0.0
+			for i := nr - 1; i >= 0; i-- {
+				idx := ix.Indexes[i]*csz + ci
+				if !cl.IsNull1D(idx) {
+					has = true
+					next = cl.FloatValue1D(idx)
+				} else if has {
+					cl.SetFloat1D(idx, next)
+					cl.SetNull1D(idx, false)
+				}
+			}
+		case ConstantFill, MeanFill:
+			fv := constant
+			if method == MeanFill {
+				n := 0
+				sum := 0.0
+				for i := 0; i < nr; i++ {
+					idx := ix.Indexes[i]*csz + ci
+					if !cl.IsNull1D(idx) {
+						sum += cl.FloatValue1D(idx)
+						n++
+					}
+				}
+				if n == 0 {
+					continue
+				}
+				fv = sum / float64(n)
+			}
+			for i := 0; i < nr; i++ {
+				idx := ix.Indexes[i]*csz + ci
+				if cl.IsNull1D(idx) {
+					cl.SetFloat1D(idx, fv)
+					cl.SetNull1D(idx, false)
+				}
+			}
+		}
+	}
+	ix.Table.Changed()
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"math"
 	"math/rand"
+	"regexp"
 	"slices"
 	"sort"
 	"strings"
@@ -40,11 +41,32 @@ type IndexView struct { //types:add
 	// Table that we are an indexed view onto
 	Table *Table
 
-	// current indexes into Table
+	// current indexes into Table -- call IndexesChanged after changing
+	// them directly, instead of via the IndexView methods
 	Indexes []int
 
 	// current Less function used in sorting
 	lessFunc LessFunc `copier:"-" view:"-" xml:"-" json:"-"`
+
+	// cache of aggregate values computed by CachedAgg
+	aggCache map[aggCacheKey][]float64 `copier:"-" view:"-" xml:"-" json:"-"`
+
+	// Table and Table.Version that the aggCache is valid for
+	aggCacheTable   *Table `copier:"-" view:"-" xml:"-" json:"-"`
+	aggCacheVersion uint64 `copier:"-" view:"-" xml:"-" json:"-"`
+
+	// generation of the Indexes that the aggCache is valid for
+	aggCacheGen uint64 `copier:"-" view:"-" xml:"-" json:"-"`
+
+	// generation counter incremented by IndexesChanged when the Indexes change
+	generation uint64 `copier:"-" view:"-" xml:"-" json:"-"`
+}
+
+// aggCacheKey is the key for the CachedAgg cache, using the column tensor
+// so that adding or deleting columns does not return another column's values
+type aggCacheKey struct {
+	col etensor.Tensor
+	agg string
 }
 
 // NewIndexView returns a new IndexView based on given table, initialized with sequential idxes
@@ -60,9 +82,18 @@ func (ix *IndexView) SetTable(et *Table) {
 	ix.Sequential()
 }
 
+// IndexesChanged increments the generation counter of the Indexes, which
+// invalidates the CachedAgg cache.  It is called by the IndexView methods
+// that change the Indexes (Sort, Filter, etc), and must be called after
+// changing the Indexes directly.
+func (ix *IndexView) IndexesChanged() {
+	ix.generation++
+}
+
 // DeleteInvalid deletes all invalid indexes from the list.
 // Call this if rows (could) have been deleted from table.
 func (ix *IndexView) DeleteInvalid() {
+	defer ix.IndexesChanged()
 	if ix.Table == nil || ix.Table.Rows <= 0 {
 		ix.Indexes = nil
 		return
@@ -77,6 +108,7 @@ func (ix *IndexView) DeleteInvalid() {
 
 // Sequential sets indexes to sequential row-wise indexes into table
 func (ix *IndexView) Sequential() { //types:add
+	defer ix.IndexesChanged()
 	if ix.Table == nil || ix.Table.Rows <= 0 {
 		ix.Indexes = nil
 		return
@@ -91,6 +123,7 @@ func (ix *IndexView) Sequential() { //types:add
 // then existing list of indexes is permuted, otherwise a new set of
 // permuted indexes are generated
 func (ix *IndexView) Permuted() {
+	defer ix.IndexesChanged()
 	if ix.Table == nil || ix.Table.Rows <= 0 {
 		ix.Indexes = nil
 		return
@@ -104,9 +137,86 @@ func (ix *IndexView) Permuted() {
 	}
 }
 
+// SampleWeighted replaces the indexes with n indexes sampled with replacement
+// from the current indexes, with probability proportional to the value of
+// the given weight column in each row, using a random number generator
+// initialized with given seed, for reproducible results.  This is useful
+// for importance-weighted visualization and bootstrap-style analyses.
+// NaN weights are treated as zero.  Returns an error, without changing
+// the indexes, for a negative n, an invalid or n-dimensional column,
+// a negative weight, or if all weights are zero.
+func (ix *IndexView) SampleWeighted(n int, weightCol string, seed int64) error {
+	ci, err := ix.Table.ColIndexTry(weightCol)
+	if err != nil {
+		return err
+	}
+	vidxs, err := ix.WeightedSample(n, ci, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return err
+	}
+	idxs := make([]int, n)
+	for i, vi := range vidxs {
+		idxs[i] = ix.Indexes[vi]
+	}
+	ix.Indexes = idxs
+	ix.IndexesChanged()
+	return nil
+}
+
+// WeightedSample returns n indexes into the view (i.e., into Indexes)
+// sampled with replacement, with probability proportional to the value
+// of the given weight column index in each row, as unnormalized
+// probabilities, e.g., for prioritized replay.  The given random number
+// generator is used if non-nil, and otherwise the global one.
+// NaN weights are treated as zero.  Returns an error for a negative n,
+// an invalid or n-dimensional column, a negative weight, or if all weights
+// are zero.
+// See SampleWeighted for a version that sets the indexes.
+func (ix *IndexView) WeightedSample(n int, weightCol int, rnd *rand.Rand) ([]int, error) {
+	if weightCol < 0 || weightCol >= ix.Table.NumCols() {
+		return nil, fmt.Errorf("etable.IndexView.WeightedSample: column index: %d out of range", weightCol)
+	}
+	col := ix.Table.Cols[weightCol]
+	cnm := ix.Table.ColNames[weightCol]
+	if col.NumDims() != 1 {
+		return nil, fmt.Errorf("etable.IndexView.WeightedSample: weight column: %s is not 1-dimensional: %w", cnm, ErrColDims)
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("etable.IndexView.WeightedSample: number of samples: %d is negative", n)
+	}
+	cum := make([]float64, len(ix.Indexes)) // cumulative distribution
+	sum := 0.0
+	for i, srw := range ix.Indexes {
+		w := col.FloatValue1D(srw)
+		switch {
+		case math.IsNaN(w):
+			w = 0
+		case w < 0:
+			return nil, fmt.Errorf("etable.IndexView.WeightedSample: negative weight: %g in column: %s row: %d", w, cnm, srw)
+		}
+		sum += w
+		cum[i] = sum
+	}
+	if sum <= 0 {
+		return nil, fmt.Errorf("etable.IndexView.WeightedSample: all weights are zero in column: %s", cnm)
+	}
+	rfloat := rand.Float64
+	if rnd != nil {
+		rfloat = rnd.Float64
+	}
+	idxs := make([]int, n)
+	for i := range idxs {
+		r := rfloat() * sum
+		j := sort.Search(len(cum), func(k int) bool { return cum[k] > r })
+		idxs[i] = min(j, len(cum)-1)
+	}
+	return idxs, nil
+}
+
 // AddIndex adds a new index to the list
 func (ix *IndexView) AddIndex(idx int) {
 	ix.Indexes = append(ix.Indexes, idx)
+	ix.IndexesChanged()
 }
 
 // Sort sorts the indexes into our Table using given Less function.
@@ -115,6 +225,7 @@ func (ix *IndexView) AddIndex(idx int) {
 func (ix *IndexView) Sort(lessFunc func(et *Table, i, j int) bool) {
 	ix.lessFunc = lessFunc
 	sort.Sort(ix)
+	ix.IndexesChanged()
 }
 
 // SortIndexes sorts the indexes into our Table directly in
@@ -122,6 +233,7 @@ func (ix *IndexView) Sort(lessFunc func(et *Table, i, j int) bool) {
 // any filtering that might have occurred.
 func (ix *IndexView) SortIndexes() {
 	sort.Ints(ix.Indexes)
+	ix.IndexesChanged()
 }
 
 const (
@@ -170,6 +282,27 @@ func (ix *IndexView) SortCol(colIndex int, ascending bool) {
 	}
 }
 
+// SortColNatural sorts the indexes into our Table according to values in
+// given column index, using either ascending or descending order, as in
+// SortCol, except that String columns are sorted in natural (numeric-aware)
+// order (see [etensor.NaturalLess]), e.g., "1", "2", "10" instead of
+// "1", "10", "2" for ID codes stored as strings.
+// Only valid for 1-dimensional columns.
+func (ix *IndexView) SortColNatural(colIndex int, ascending bool) {
+	cl := ix.Table.Cols[colIndex]
+	if cl.DataType() != etensor.STRING {
+		ix.SortCol(colIndex, ascending)
+		return
+	}
+	ix.Sort(func(et *Table, i, j int) bool {
+		if ascending {
+			return etensor.NaturalLess(cl.StringValue1D(i), cl.StringValue1D(j))
+		} else {
+			return etensor.NaturalLess(cl.StringValue1D(j), cl.StringValue1D(i))
+		}
+	})
+}
+
 // SortColNames sorts the indexes into our Table according to values in
 // given column names, using either ascending or descending order.
 // Only valid for 1-dimensional columns.
@@ -244,6 +377,7 @@ func (ix *IndexView) SortCols(colIndexes []int, ascending bool) {
 func (ix *IndexView) SortStable(lessFunc func(et *Table, i, j int) bool) {
 	ix.lessFunc = lessFunc
 	sort.Stable(ix)
+	ix.IndexesChanged()
 }
 
 // SortStableColName sorts the indexes into our Table according to values in
@@ -357,6 +491,7 @@ func (ix *IndexView) Filter(filterFunc func(et *Table, row int) bool) {
 			ix.Indexes = append(ix.Indexes[:i], ix.Indexes[i+1:]...)
 		}
 	}
+	ix.IndexesChanged()
 }
 
 // FilterColName filters the indexes into our Table according to values in
@@ -405,6 +540,38 @@ func (ix *IndexView) FilterCol(colIndex int, str string, exclude, contains, igno
 	})
 }
 
+// Head truncates the indexes to the first n in the current order
+// (e.g., after sorting), or leaves all if there are fewer than n.
+func (ix *IndexView) Head(n int) {
+	n = max(0, min(n, len(ix.Indexes)))
+	ix.Indexes = ix.Indexes[:n]
+	ix.IndexesChanged()
+}
+
+// Tail truncates the indexes to the last n in the current order
+// (e.g., the most recent epochs), or leaves all if there are fewer than n.
+func (ix *IndexView) Tail(n int) {
+	n = max(0, min(n, len(ix.Indexes)))
+	ix.Indexes = slices.Clone(ix.Indexes[len(ix.Indexes)-n:])
+	ix.IndexesChanged()
+}
+
+// HeadView returns a new view with the first n indexes of this one,
+// which is not modified.  See Head.
+func (ix *IndexView) HeadView(n int) *IndexView {
+	nix := ix.Clone()
+	nix.Head(n)
+	return nix
+}
+
+// TailView returns a new view with the last n indexes of this one,
+// which is not modified.  See Tail.
+func (ix *IndexView) TailView(n int) *IndexView {
+	nix := ix.Clone()
+	nix.Tail(n)
+	return nix
+}
+
 // NewTable returns a new table with column data organized according to
 // the indexes
 func (ix *IndexView) NewTable() *Table {
@@ -425,6 +592,26 @@ func (ix *IndexView) NewTable() *Table {
 	return nt
 }
 
+// ApplyToTable rewrites the underlying Table column data in place according
+// to the current indexes (e.g., after sorting or filtering), so that the
+// Table itself is physically in that order, and then resets the indexes
+// to Sequential.  Uses NewTable to copy the data and swaps in the new
+// columns, preserving column meta data.  Note that any other IndexView's
+// on the same Table will no longer be valid after this call.
+func (ix *IndexView) ApplyToTable() {
+	if ix.Table == nil {
+		return
+	}
+	nt := ix.NewTable()
+	for ci, cl := range nt.Cols {
+		cl.CopyMetaData(ix.Table.Cols[ci])
+		ix.Table.Cols[ci] = cl
+	}
+	ix.Table.Rows = nt.Rows
+	ix.Sequential()
+	ix.Table.Changed()
+}
+
 // AggCol applies given aggregation function to each element in the given column, using float64
 // conversions of the values.  init is the initial value for the agg variable.
 // Operates independently over each cell on n-dimensional columns and returns the result as a slice
@@ -458,6 +645,79 @@ func (ix *IndexView) AggCol(colIndex int, ini float64, fun etensor.AggFunc) []fl
 	return ag
 }
 
+// ColRange returns the min and max values in the given column index across
+// the rows of the view, for the given cell index within each row for
+// n-dimensional columns (ignored for 1D columns), skipping Null and NaN values,
+// in a single pass without allocating, e.g., for the eplot point size
+// and color ranges.
+// Returns 0, 0 if there are no valid values or the cell index is out of range.
+func (ix *IndexView) ColRange(colIndex, tensorIdx int) (min, max float64) {
+	cl := ix.Table.Cols[colIndex]
+	_, csz := cl.RowCellSize()
+	if csz == 1 {
+		tensorIdx = 0
+	} else if tensorIdx < 0 || tensorIdx >= csz {
+		return 0, 0
+	}
+	has := false
+	for _, srw := range ix.Indexes {
+		i := srw*csz + tensorIdx
+		if cl.IsNull1D(i) {
+			continue
+		}
+		val := cl.FloatValue1D(i)
+		if math.IsNaN(val) {
+			continue
+		}
+		if !has {
+			min, max = val, val
+			has = true
+			continue
+		}
+		if val < min {
+			min = val
+		}
+		if val > max {
+			max = val
+		}
+	}
+	return
+}
+
+// CachedAgg returns the aggregate values for given column index and
+// aggregation name (e.g., agg.Aggs String), computed by given function if
+// not already cached from a previous call with the same column and name.
+// The cache is invalidated when the Indexes or the Table change, which are
+// tracked via IndexesChanged and Table.Version, so any direct changes to
+// the Indexes must be followed by an IndexesChanged call, and to the column
+// data by a Table.Changed call.  Values are cached per column tensor, so
+// adding or deleting columns does not return the values of another column.  This is useful for
+// avoiding recomputing the same aggregates on every GUI update.
+// See agg.CachedIndex for the standard way to use it.
+// A copy of the cached values is returned.  Not safe for concurrent use.
+func (ix *IndexView) CachedAgg(colIndex int, aggName string, fun func(ix *IndexView, colIndex int) []float64) []float64 {
+	if ix.aggCache == nil || ix.aggCacheTable != ix.Table || ix.aggCacheVersion != ix.Table.Version() || ix.aggCacheGen != ix.generation {
+		ix.ClearAggCache()
+		ix.aggCache = make(map[aggCacheKey][]float64)
+		ix.aggCacheTable = ix.Table
+		ix.aggCacheVersion = ix.Table.Version()
+		ix.aggCacheGen = ix.generation
+	}
+	key := aggCacheKey{ix.Table.Cols[colIndex], aggName}
+	vals, ok := ix.aggCache[key]
+	if !ok {
+		vals = fun(ix, colIndex)
+		ix.aggCache[key] = vals
+	}
+	return slices.Clone(vals)
+}
+
+// ClearAggCache clears the cache of aggregate values used by CachedAgg
+func (ix *IndexView) ClearAggCache() {
+	ix.aggCache = nil
+	ix.aggCacheTable = nil
+}
+
 // Clone returns a copy of the current index view with its own index memory
 func (ix *IndexView) Clone() *IndexView {
 	nix := &IndexView{}
@@ -469,6 +729,7 @@ func (ix *IndexView) Clone() *IndexView {
 func (ix *IndexView) CopyFrom(oix *IndexView) {
 	ix.Table = oix.Table
 	ix.Indexes = slices.Clone(oix.Indexes)
+	ix.IndexesChanged()
 }
 
 // AddRows adds n rows to end of underlying Table, and to the indexes in this view
@@ -478,6 +739,7 @@ func (ix *IndexView) AddRows(n int) { //types:add
 	for i := stidx; i < stidx+n; i++ {
 		ix.Indexes = append(ix.Indexes, i)
 	}
+	ix.IndexesChanged()
 }
 
 // InsertRows adds n rows to end of underlying Table, and to the indexes starting at
@@ -490,11 +752,13 @@ func (ix *IndexView) InsertRows(at, n int) {
 		nw[i] = stidx + i
 	}
 	ix.Indexes = append(ix.Indexes[:at], append(nw, ix.Indexes[at:]...)...)
+	ix.IndexesChanged()
 }
 
 // DeleteRows deletes n rows of indexes starting at given index in the list of indexes
 func (ix *IndexView) DeleteRows(at, n int) {
 	ix.Indexes = append(ix.Indexes[:at], ix.Indexes[at+n:]...)
+	ix.IndexesChanged()
 }
 
 // RowsByStringIndex returns the list of *our indexes* whose row in the table has
@@ -554,6 +818,69 @@ func (ix *IndexView) RowsByStringTry(colNm string, str string, contains, ignoreC
 	return ix.RowsByStringIndex(ci, str, contains, ignoreCase), nil
 }
 
+// RowsByStringRegexpIndex returns the list of *our indexes* whose row in the table
+// has a string value in given column index that matches given compiled regular
+// expression (de-reference our indexes to get actual row).
+// Use anchors (^ $) in the expression to match the entire value.
+func (ix *IndexView) RowsByStringRegexpIndex(colIndex int, re *regexp.Regexp) []int {
+	col := ix.Table.Cols[colIndex]
+	var idxs []int
+	for idx, srw := range ix.Indexes {
+		if re.MatchString(col.StringValue1D(srw)) {
+			idxs = append(idxs, idx)
+		}
+	}
+	return idxs
+}
+
+// RowsByStringRegexp returns the list of *our indexes* whose row in the table
+// has a string value in given column name that matches given compiled regular
+// expression (de-reference our indexes to get actual row).
+// Use anchors (^ $) in the expression to match the entire value.
+// returns error message for invalid column name.
+func (ix *IndexView) RowsByStringRegexp(colNm string, re *regexp.Regexp) ([]int, error) {
+	ci, err := ix.Table.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return ix.RowsByStringRegexpIndex(ci, re), nil
+}
+
+// GroupByIndex returns one IndexView per distinct string value of the given
+// column index, each sharing the same Table and containing the rows of this
+// view with that value, in their current order.
+// See GroupBy for more info.
+func (ix *IndexView) GroupByIndex(colIndex int) map[string]*IndexView {
+	col := ix.Table.Cols[colIndex]
+	gps := make(map[string]*IndexView)
+	for _, srw := range ix.Indexes {
+		val := col.StringValue1D(srw)
+		gp, has := gps[val]
+		if !has {
+			gp = &IndexView{Table: ix.Table}
+			gps[val] = gp
+		}
+		gp.Indexes = append(gp.Indexes, srw)
+	}
+	return gps
+}
+
+// GroupBy returns one IndexView per distinct string value of the given
+// column name, each sharing the same Table and containing the rows of this
+// view with that value, in their current order.  Returns an error for
+// an invalid column name.  This is a convenience for quick inline
+// grouping, e.g., for ad-hoc per-condition processing in a for range loop
+// (in random map order) -- see split.GroupBy for the full Splits API,
+// which supports multiple levels of grouping, ordered groups, and
+// aggregation.
+func (ix *IndexView) GroupBy(colNm string) (map[string]*IndexView, error) {
+	ci, err := ix.Table.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return ix.GroupByIndex(ci), nil
+}
+
 // Len returns the length of the index list
 func (ix *IndexView) Len() int {
 	return len(ix.Indexes)
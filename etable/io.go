@@ -13,6 +13,7 @@ import (
 	"log"
 	"math"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -58,6 +59,51 @@ const (
 	NoHeaders = false
 )
 
+// CSVOptions are options for parsing numeric values when reading CSV files,
+// e.g., for European-formatted data such as 1.234,5 -- the zero value
+// gives the standard period decimal separator with no thousands separator.
+// If the Decimal separator is the same as the field delimiter (e.g., a comma
+// decimal with the Comma delimiter), numeric fields must be quoted in the file
+// to be read properly -- it is best to use the Tab or Space delimiter instead.
+// The options only apply to numeric columns: String columns are read as is.
+type CSVOptions struct {
+
+	// the decimal separator in numeric values, e.g., ',' -- '.' if 0
+	Decimal rune
+
+	// the thousands separator in numeric values, e.g., '.' or ' ', which is
+	// removed before parsing -- none if 0
+	Thousands rune
+
+	// read empty numeric cells as 0 values, as in older versions, instead of
+	// the default of marking them as missing (Null), with a NaN value for
+	// float columns and 0 for integer columns, so that they are skipped by
+	// the aggregation functions (see agg.SkipMissing)
+	EmptyZero bool
+}
+
+// IsDefault returns true if these are the default numeric format options,
+// which do not require any conversion of numeric values.
+func (op *CSVOptions) IsDefault() bool {
+	return (op.Decimal == 0 || op.Decimal == '.') && op.Thousands == 0
+}
+
+// Number converts given numeric value string in these options' format
+// to the standard format, removing the thousands separator and replacing
+// the decimal separator with a period.
+func (op *CSVOptions) Number(str string) string {
+	if op.IsDefault() {
+		return str
+	}
+	if op.Thousands != 0 {
+		str = strings.ReplaceAll(str, string(op.Thousands), "")
+	}
+	if op.Decimal != 0 && op.Decimal != '.' {
+		str = strings.ReplaceAll(str, string(op.Decimal), ".")
+	}
+	return str
+}
+
 // SaveCSV writes a table to a comma-separated-values (CSV) file
 // (where comma = any delimiter, specified in the delim arg).
 // If headers = true then generate C++ emergent-tyle column headers.
@@ -94,6 +140,59 @@ func (ix *IndexView) SaveCSV(filename core.Filename, delim Delims, headers bool)
 	return err
 }
 
+// AppendCSV appends the rows of the table starting at given startRow to
+// a comma-separated-values (CSV) file (where comma = any delimiter, specified
+// in the delim arg), without rewriting the rows already in the file, e.g.,
+// for incrementally logging a long training run.  If the file does not exist
+// or is empty, it is created with C++ emergent-style column headers as in
+// SaveCSV with headers = true.  Otherwise the existing header row must match
+// the headers of the table, and only the new rows are written.  Returns the
+// startRow to use for the next call, which is the number of rows in the table.
+func (dt *Table) AppendCSV(filename core.Filename, delim Delims, startRow int) (int, error) {
+	if startRow < 0 || startRow > dt.Rows {
+		return startRow, fmt.Errorf("etable.Table.AppendCSV: startRow: %d out of range for table with %d rows", startRow, dt.Rows)
+	}
+	hdrs := dt.EmerHeaders()
+	headers := true
+	if fp, err := os.Open(string(filename)); err == nil {
+		fhdrs, err := newCSVReader(fp, delim).Read()
+		fp.Close()
+		switch {
+		case err == io.EOF:
+		case err != nil:
+			return startRow, errors.Log(fmt.Errorf("etable.Table.AppendCSV: reading headers of file: %s: %w", filename, err))
+		case !slices.Equal(fhdrs, hdrs):
+			return startRow, errors.Log(fmt.Errorf("etable.Table.AppendCSV: headers of file: %s do not match the table columns", filename))
+		default:
+			headers = false
+		}
+	}
+	fp, err := os.OpenFile(string(filename), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return startRow, errors.Log(err)
+	}
+	defer fp.Close()
+	bw := bufio.NewWriter(fp)
+	ncol := len(hdrs)
+	if headers {
+		if _, err := dt.WriteCSVHeaders(bw, delim); err != nil {
+			return startRow, errors.Log(err)
+		}
+	}
+	cw := csv.NewWriter(bw)
+	cw.Comma = delim.Rune()
+	for ri := startRow; ri < dt.Rows; ri++ {
+		if err := dt.WriteCSVRowWriter(cw, ri, ncol); err != nil {
+			return startRow, errors.Log(err)
+		}
+	}
+	cw.Flush()
+	if err := bw.Flush(); err != nil {
+		return startRow, errors.Log(err)
+	}
+	return dt.Rows, nil
+}
+
 // OpenCSV reads a table from a comma-separated-values (CSV) file
 // (where comma = any delimiter, specified in the delim arg),
 // using the Go standard encoding/csv reader conforming to the official CSV standard.
@@ -102,7 +201,9 @@ func (ix *IndexView) SaveCSV(filename core.Filename, delim Delims, headers bool)
 // The C++ emergent column headers are parsed -- these have full configuration
 // information for tensor dimensionality.
 // If the table DOES have existing columns, then those are used robustly
-// for whatever information fits from each row of the file.
+// for whatever information fits from each row of the file.  Otherwise,
+// rows with the wrong number of fields for the columns configured from the
+// headers are read as far as possible, and reported with their line numbers.
 func (dt *Table) OpenCSV(filename core.Filename, delim Delims) error { //types:add
 	fp, err := os.Open(string(filename))
 	if err != nil {
@@ -112,6 +213,19 @@ func (dt *Table) OpenCSV(filename core.Filename, delim Delims) error { //types:a
 	return dt.ReadCSV(bufio.NewReader(fp), delim)
 }
 
+// OpenCSVOptions reads a table from a comma-separated-values (CSV) file
+// (where comma = any delimiter, specified in the delim arg), using given
+// options for parsing numeric values, e.g., with a comma decimal separator.
+// See [Table.OpenCSV] and [CSVOptions] for more info.
+func (dt *Table) OpenCSVOptions(filename core.Filename, delim Delims, opts CSVOptions) error {
+	fp, err := os.Open(string(filename))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	return dt.ReadCSVOptions(bufio.NewReader(fp), delim, opts)
+}
+
 // OpenFS is the version of [Table.OpenCSV] that uses an [fs.FS] filesystem.
 func (dt *Table) OpenFS(fsys fs.FS, filename string, delim Delims) error {
 	fp, err := fsys.Open(filename)
@@ -153,40 +267,203 @@ func (ix *IndexView) OpenFS(fsys fs.FS, filename string, delim Delims) error {
 // information for tensor dimensionality.
 // If the table DOES have existing columns, then those are used robustly
 // for whatever information fits from each row of the file.
+// Rows with the wrong number of fields are still read as far as possible,
+// and the returned error lists each such row with its line number in the file.
+// A leading UTF-8 byte order mark (BOM) and CRLF line endings, as in files
+// exported from Excel on Windows, are handled.
 func (dt *Table) ReadCSV(r io.Reader, delim Delims) error {
-	cr := csv.NewReader(r)
+	return dt.ReadCSVOptions(r, delim, CSVOptions{})
+}
+
+// utf8BOM is the UTF-8 byte order mark that starts some CSV files
+const utf8BOM = "\ufeff"
+
+// newCSVReader returns a csv.Reader for given reader and delimiter,
+// skipping any leading UTF-8 byte order mark, which would otherwise be
+// part of the first column name.  The csv.Reader removes the carriage
+// returns of CRLF line endings.
+func newCSVReader(r io.Reader, delim Delims) *csv.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(len(utf8BOM)); err == nil && string(bom) == utf8BOM {
+		br.Discard(len(utf8BOM))
+	}
+	cr := csv.NewReader(br)
 	cr.Comma = delim.Rune()
-	rec, err := cr.ReadAll() // todo: lazy, avoid resizing
-	if err != nil || len(rec) == 0 {
+	cr.FieldsPerRecord = -1 // validated per row in ReadCSVRow
+	return cr
+}
+
+// ReadCSVOptions reads a table from a comma-separated-values (CSV) file
+// (where comma = any delimiter, specified in the delim arg), using given
+// options for parsing numeric values, e.g., with a comma decimal separator.
+// See [Table.ReadCSV] and [CSVOptions] for more info.
+func (dt *Table) ReadCSVOptions(r io.Reader, delim Delims, opts CSVOptions) error {
+	cr := newCSVReader(r, delim)
+	var rec [][]string
+	var lines []int
+	for {
+		rc, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		ln, _ := cr.FieldPos(0)
+		rec = append(rec, rc)
+		lines = append(lines, ln)
+	}
+	return dt.readCSVRecords(rec, lines, false, &opts)
+}
+
+// OpenCSVRange reads a table from a comma-separated-values (CSV) file
+// (where comma = any delimiter, specified in the delim arg), as in
+// [Table.OpenCSV], but only reading the window of nRows rows starting at
+// startRow, e.g., to look at part of a very large log file.
+// The first line of the file must be a header, and startRow is 0-based
+// not counting the header, so startRow = 0 is the first data row.
+// If nRows < 0, all rows from startRow to the end are read.
+// Earlier rows are skipped without parsing them into the table, and
+// column types of plain headers are inferred from the rows read.
+func (dt *Table) OpenCSVRange(filename core.Filename, delim Delims, startRow, nRows int) error {
+	fp, err := os.Open(string(filename))
+	if err != nil {
+		return errors.Log(err)
+	}
+	defer fp.Close()
+	return dt.ReadCSVRange(bufio.NewReader(fp), delim, startRow, nRows)
+}
+
+// ReadCSVRange reads a table from a comma-separated-values (CSV) file
+// (where comma = any delimiter, specified in the delim arg), only reading
+// the window of nRows rows starting at 0-based data row startRow, after
+// the header.  See [Table.OpenCSVRange] for more info.
+func (dt *Table) ReadCSVRange(r io.Reader, delim Delims, startRow, nRows int) error {
+	cr := newCSVReader(r, delim)
+	hdr, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
 		return err
 	}
+	rec := [][]string{hdr}
+	lines := []int{1}
+	cr.ReuseRecord = true // skipped rows are not kept
+	for ri := 0; ri < startRow; ri++ {
+		if _, err := cr.Read(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	cr.ReuseRecord = false
+	for ri := 0; nRows < 0 || ri < nRows; ri++ {
+		rc, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		ln, _ := cr.FieldPos(0)
+		rec = append(rec, rc)
+		lines = append(lines, ln)
+	}
+	return dt.readCSVRecords(rec, lines, true, &CSVOptions{})
+}
+
+// readCSVRecords reads given CSV records, at given line numbers in the file,
+// into the table, configuring the columns from the first record if the table
+// has no columns or it is an emergent header.  If hasHeader, then the first
+// record is always a header, and is otherwise skipped.
+func (dt *Table) readCSVRecords(rec [][]string, lines []int, hasHeader bool, opts *CSVOptions) error {
+	if len(rec) == 0 {
+		return nil
+	}
 	rows := len(rec)
 	// cols := len(rec[0])
 	strow := 0
+	configured := false // columns configured from the header
+	var errs []error
 	if dt.NumCols() == 0 || DetectEmerHeaders(rec[0]) {
-		sc, err := SchemaFromHeaders(rec[0], rec)
+		srec := rec
+		if !opts.IsDefault() { // infer types from converted numbers
+			srec = make([][]string, len(rec))
+			for ri, rc := range rec {
+				srec[ri] = make([]string, len(rc))
+				for fi, str := range rc {
+					if ns := opts.Number(str); InferDataType(ns) != etensor.STRING {
+						str = ns
+					}
+					srec[ri][fi] = str
+				}
+			}
+		}
+		sc, err := SchemaFromHeaders(rec[0], srec)
 		if err != nil {
 			log.Println(err.Error())
 			return err
 		}
 		strow++
 		rows--
-		dt.SetFromSchema(sc, rows)
+		if err := dt.setFromSchemaTry(sc, rows); err != nil {
+			errs = append(errs, err)
+		}
+		configured = true
+	} else if hasHeader {
+		strow++
+		rows--
+		if err := dt.DuplicateColNames(); err != nil {
+			errs = append(errs, err)
+		}
 	}
 	dt.SetNumRows(rows)
 	for ri := 0; ri < rows; ri++ {
-		dt.ReadCSVRow(rec[ri+strow], ri)
+		err := dt.readCSVRow(rec[ri+strow], ri, opts)
+		if err != nil && configured { // existing columns: read as far as possible
+			errs = append(errs, fmt.Errorf("line %d: %w", lines[ri+strow], err))
+		}
 	}
-	return nil
+	dt.Changed()
+	return errors.Join(errs...)
 }
 
-// ReadCSVRow reads a record of CSV data into given row in table
+// ReadCSVRow reads a record of CSV data into given row in table,
+// reading as many of the fields as fit into the columns.
+// See ReadCSVRowTry for a version that validates the number of fields.
 func (dt *Table) ReadCSVRow(rec []string, row int) {
+	dt.readCSVRow(rec, row, &CSVOptions{})
+}
+
+// ReadCSVRowTry reads a record of CSV data into given row in table, as in
+// ReadCSVRow, and returns an error naming the row and the expected vs.
+// actual number of fields if the record does not have exactly one field
+// per cell element across all columns (plus a leading "_D:" for emergent
+// data rows), in which case whatever fits is still read.
+func (dt *Table) ReadCSVRowTry(rec []string, row int) error {
+	return dt.readCSVRow(rec, row, &CSVOptions{})
+}
+
+// readCSVRow is ReadCSVRowTry using given options for numeric columns
+func (dt *Table) readCSVRow(rec []string, row int, opts *CSVOptions) error {
 	tc := dt.NumCols()
 	ci := 0
-	if rec[0] == "_D:" { // emergent data row
+	if len(rec) > 0 && rec[0] == "_D:" { // emergent data row
 		ci++
 	}
+	nf := ci
+	for j := 0; j < tc; j++ {
+		_, csz := dt.Cols[j].RowCellSize()
+		nf += csz
+	}
+	var err error
+	if len(rec) != nf {
+		err = fmt.Errorf("etable.Table ReadCSVRow: row %d has %d fields, expected %d", row, len(rec), nf)
+	}
+	if ci >= len(rec) {
+		return err
+	}
 	nan := math.NaN()
 	for j := 0; j < tc; j++ {
 		tsr := dt.Cols[j]
@@ -194,22 +471,41 @@ func (dt *Table) ReadCSVRow(rec []string, row int) {
 		stoff := row * csz
 		for cc := 0; cc < csz; cc++ {
 			str := rec[ci]
-			if tsr.DataType() != etensor.STRING {
-				if str == "" || str == "NaN" || str == "-NaN" || str == "Inf" || str == "-Inf" {
+			if dtp := tsr.DataType(); dtp != etensor.STRING {
+				switch {
+				case str == "" && opts.EmptyZero:
+					clearNull(tsr, stoff+cc)
+					tsr.SetFloat1D(stoff+cc, 0)
+				case str == "" || str == "NaN" || str == "-NaN" || str == "Inf" || str == "-Inf":
 					tsr.SetNull1D(stoff+cc, true) // empty = missing
-					tsr.SetFloat1D(stoff+cc, nan)
-				} else {
-					tsr.SetString1D(stoff+cc, str)
+					if dtp == etensor.FLOAT32 || dtp == etensor.FLOAT64 {
+						tsr.SetFloat1D(stoff+cc, nan)
+					} else {
+						tsr.SetFloat1D(stoff+cc, 0) // no NaN for ints
+					}
+				default:
+					clearNull(tsr, stoff+cc)
+					tsr.SetString1D(stoff+cc, opts.Number(str))
 				}
 			} else {
 				tsr.SetString1D(stoff+cc, str)
 			}
 			ci++
 			if ci >= len(rec) {
-				return
+				return err
 			}
 		}
 	}
+	return err
+}
+
+// clearNull clears the Null flag of given element of given tensor, if set,
+// e.g., when reading a value into an existing table, without allocating
+// the Null flags otherwise.
+func clearNull(tsr etensor.Tensor, i int) {
+	if tsr.IsNull1D(i) {
+		tsr.SetNull1D(i, false)
+	}
 }
 
 // SchemaFromHeaders attempts to configure a Table Schema based on the headers
@@ -338,6 +634,9 @@ func SchemaFromPlainHeaders(hdrs []string, rec [][]string) (Schema, error) {
 		dt := etensor.STRING
 		nmatch := 0
 		for ri := 1; ri < nr; ri++ {
+			if ci >= len(rec[ri]) {
+				continue
+			}
 			rv := rec[ri][ci]
 			if rv == "" {
 				continue
@@ -566,3 +865,72 @@ func (dt *Table) EmerHeaders() []string {
 	}
 	return hdrs
 }
+
+//////////////////////////////////////////////////////////////////////////
+// WriteMarkdown
+
+// WriteMarkdown writes the table as a GitHub-flavored Markdown table,
+// e.g., for pasting into issues and docs.  If maxRows > 0 then at most
+// that many rows are written, followed by a note about the number of
+// rows not shown.  Floats are formatted using the "precision" MetaData
+// if set, and higher-dimensional cells are shown as their Label summary.
+func (dt *Table) WriteMarkdown(w io.Writer, maxRows int) error {
+	prec := -1
+	if ps, ok := dt.MetaData["precision"]; ok {
+		prec, _ = strconv.Atoi(ps)
+	}
+	bw := bufio.NewWriter(w)
+	bw.WriteString("|")
+	for _, nm := range dt.ColNames {
+		bw.WriteString(" " + markdownEscape(nm) + " |")
+	}
+	bw.WriteString("\n|")
+	for _, tsr := range dt.Cols {
+		if tsr.NumDims() == 1 && tsr.DataType() != etensor.STRING && tsr.DataType() != etensor.BOOL {
+			bw.WriteString(" ---: |") // right-align numbers
+		} else {
+			bw.WriteString(" --- |")
+		}
+	}
+	bw.WriteString("\n")
+	nr := dt.Rows
+	if maxRows > 0 && nr > maxRows {
+		nr = maxRows
+	}
+	for row := 0; row < nr; row++ {
+		bw.WriteString("|")
+		for _, tsr := range dt.Cols {
+			bw.WriteString(" " + markdownEscape(markdownCell(tsr, row, prec)) + " |")
+		}
+		bw.WriteString("\n")
+	}
+	if nr < dt.Rows {
+		fmt.Fprintf(bw, "\n_%d of %d rows shown_\n", nr, dt.Rows)
+	}
+	return bw.Flush()
+}
+
+// markdownCell returns the string for given column and row for WriteMarkdown
+func markdownCell(tsr etensor.Tensor, row, prec int) string {
+	if tsr.NumDims() > 1 {
+		cell, err := tsr.SubSpaceTry([]int{row})
+		if err != nil {
+			return ""
+		}
+		if lb, ok := cell.(interface{ Label() string }); ok {
+			return lb.Label()
+		}
+		return ""
+	}
+	if typ := tsr.DataType(); prec <= 0 || (typ != etensor.FLOAT32 && typ != etensor.FLOAT64) {
+		return tsr.StringValue1D(row)
+	}
+	return strconv.FormatFloat(tsr.FloatValue1D(row), 'g', prec, 64)
+}
+
+// markdownEscape escapes the characters in given string that would
+// break a Markdown table cell
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
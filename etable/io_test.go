@@ -5,10 +5,14 @@
 package etable
 
 import (
+	"math"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
+	"cogentcore.org/core/core"
 	"github.com/emer/etable/v2/etensor"
 )
 
@@ -105,3 +109,365 @@ func TestReadEmerDat(t *testing.T) {
 		dt.WriteCSV(fo, '\t', Headers)
 	}
 }
+
+func TestReadCSVFieldCount(t *testing.T) {
+	csv := `Name,X,Y
+a,1,2
+b,3
+c,4,5
+d,6,7,8
+`
+	dt := &Table{}
+	err := dt.ReadCSV(strings.NewReader(csv), Comma)
+	if err == nil {
+		t.Fatal("ReadCSV: expected error for bad field counts")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "line 3:") || !strings.Contains(msg, "has 2 fields, expected 3") {
+		t.Errorf("ReadCSV: short row error not reported for line 3: %s", msg)
+	}
+	if !strings.Contains(msg, "line 5:") || !strings.Contains(msg, "has 4 fields, expected 3") {
+		t.Errorf("ReadCSV: long row error not reported for line 5: %s", msg)
+	}
+	if strings.Contains(msg, "line 2:") || strings.Contains(msg, "line 4:") {
+		t.Errorf("ReadCSV: valid rows reported as errors: %s", msg)
+	}
+	if dt.Rows != 4 {
+		t.Errorf("ReadCSV: rows %d != 4", dt.Rows)
+	}
+	if dt.CellFloat("X", 1) != 3 || dt.CellFloat("Y", 3) != 7 {
+		t.Errorf("ReadCSV: fitting values not read")
+	}
+	if err := dt.ReadCSVRowTry([]string{"e", "9", "10"}, 0); err != nil {
+		t.Error(err)
+	}
+	if err := dt.ReadCSVRowTry([]string{"f", "11"}, 1); err == nil {
+		t.Error("ReadCSVRowTry: expected error for short row")
+	}
+	if dt.CellFloat("X", 1) != 11 {
+		t.Errorf("ReadCSVRowTry: fitting value not read")
+	}
+
+	// existing columns are read as far as possible, without errors
+	et := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"X", etensor.FLOAT64, nil, nil},
+	}, 0)
+	if err := et.ReadCSV(strings.NewReader("a,1\nb\nc,4,5\n"), Comma); err != nil {
+		t.Errorf("ReadCSV existing columns: unexpected error: %v", err)
+	}
+	if et.Rows != 3 || et.CellFloat("X", 2) != 4 {
+		t.Errorf("ReadCSV existing columns: rows %d, X[2] %v", et.Rows, et.CellFloat("X", 2))
+	}
+}
+
+func TestCSVTypes(t *testing.T) {
+	dt := New(Schema{
+		{"Bool", etensor.BOOL, nil, nil},
+		{"Uint8", etensor.UINT8, []int{2}, nil},
+		{"Int32", etensor.INT32, nil, nil},
+		{"Float32", etensor.FLOAT32, nil, nil},
+	}, 2)
+	for i, cl := range dt.Cols {
+		if cl == nil {
+			t.Fatalf("column %s: nil tensor", dt.ColNames[i])
+		}
+	}
+	dt.SetCellFloat("Bool", 1, 1)
+	dt.SetCellTensorFloat1D("Uint8", 1, 1, 200)
+	dt.SetCellFloat("Int32", 0, -5)
+	var b strings.Builder
+	if err := dt.WriteCSV(&b, Tab, Headers); err != nil {
+		t.Fatal(err)
+	}
+	rt := &Table{}
+	if err := rt.ReadCSV(strings.NewReader(b.String()), Tab); err != nil {
+		t.Fatal(err)
+	}
+	if rt.Cols[0].DataType() != etensor.BOOL || rt.Cols[1].DataType() != etensor.UINT8 {
+		t.Errorf("CSV types: %v %v", rt.Cols[0].DataType(), rt.Cols[1].DataType())
+	}
+	if rt.CellFloat("Bool", 1) != 1 || rt.CellFloat("Bool", 0) != 0 {
+		t.Errorf("CSV Bool values not read")
+	}
+	if rt.CellTensorFloat1D("Uint8", 1, 1) != 200 || rt.CellFloat("Int32", 0) != -5 {
+		t.Errorf("CSV Uint8 / Int32 values not read")
+	}
+}
+
+func TestCSVOptions(t *testing.T) {
+	csv := "Name\tCount\tValue\n" +
+		"a,b\t1.234\t1.234,5\n" +
+		"c\t12\t-0,25\n"
+	dt := &Table{}
+	if err := dt.ReadCSVOptions(strings.NewReader(csv), Tab, CSVOptions{Decimal: ',', Thousands: '.'}); err != nil {
+		t.Fatal(err)
+	}
+	if dt.ColByName("Count").DataType() != etensor.INT64 || dt.ColByName("Value").DataType() != etensor.FLOAT64 {
+		t.Errorf("CSVOptions types: %v %v", dt.ColByName("Count").DataType(), dt.ColByName("Value").DataType())
+	}
+	if v := dt.CellFloat("Count", 0); v != 1234 {
+		t.Errorf("CSVOptions thousands: %v != 1234", v)
+	}
+	if v := dt.CellFloat("Value", 0); v != 1234.5 {
+		t.Errorf("CSVOptions decimal: %v != 1234.5", v)
+	}
+	if v := dt.CellFloat("Value", 1); v != -.25 {
+		t.Errorf("CSVOptions decimal: %v != -0.25", v)
+	}
+	if s := dt.CellString("Name", 0); s != "a,b" {
+		t.Errorf("CSVOptions should not change strings: %q", s)
+	}
+
+	// default options: period decimal
+	dt = &Table{}
+	if err := dt.ReadCSV(strings.NewReader("Value\n1.5\n2\n"), Tab); err != nil {
+		t.Fatal(err)
+	}
+	if v := dt.CellFloat("Value", 0); v != 1.5 {
+		t.Errorf("default decimal: %v != 1.5", v)
+	}
+}
+
+func TestReadCSVEmptyCells(t *testing.T) {
+	csv := "Name\tCount\tValue\n" +
+		"a\t1\t1.5\n" +
+		"b\t\t\n" +
+		"c\t3\t2.5\n"
+	dt := &Table{}
+	if err := dt.ReadCSV(strings.NewReader(csv), Tab); err != nil {
+		t.Fatal(err)
+	}
+	cnt := dt.ColByName("Count")
+	val := dt.ColByName("Value")
+	if cnt.DataType() != etensor.INT64 || val.DataType() != etensor.FLOAT64 {
+		t.Fatalf("empty cells types: %v %v", cnt.DataType(), val.DataType())
+	}
+	if !cnt.IsNull1D(1) || cnt.FloatValue1D(1) != 0 {
+		t.Errorf("empty int cell: null: %v value: %v", cnt.IsNull1D(1), cnt.FloatValue1D(1))
+	}
+	if !val.IsNull1D(1) || !math.IsNaN(val.FloatValue1D(1)) {
+		t.Errorf("empty float cell: null: %v value: %v", val.IsNull1D(1), val.FloatValue1D(1))
+	}
+	if cnt.IsNull1D(0) || val.IsNull1D(2) || val.FloatValue1D(2) != 2.5 {
+		t.Errorf("non-empty cells should not be null")
+	}
+
+	dt = &Table{}
+	if err := dt.ReadCSVOptions(strings.NewReader(csv), Tab, CSVOptions{EmptyZero: true}); err != nil {
+		t.Fatal(err)
+	}
+	cnt = dt.ColByName("Count")
+	val = dt.ColByName("Value")
+	if cnt.IsNull1D(1) || cnt.FloatValue1D(1) != 0 || val.IsNull1D(1) || val.FloatValue1D(1) != 0 {
+		t.Errorf("EmptyZero: null: %v %v values: %v %v", cnt.IsNull1D(1), val.IsNull1D(1), cnt.FloatValue1D(1), val.FloatValue1D(1))
+	}
+}
+
+func TestReadCSVColOrder(t *testing.T) {
+	hdr := []string{"Zeta", "Alpha", "Mu", "Beta", "Omega", "Gamma"}
+	csv := strings.Join(hdr, ",") + "\n" + "1,a,2.5,b,3,c\n"
+	dt := &Table{}
+	if err := dt.ReadCSV(strings.NewReader(csv), Comma); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(dt.ColNames, hdr) {
+		t.Errorf("ReadCSV column order: %v != %v", dt.ColNames, hdr)
+	}
+	for i, nm := range hdr {
+		if ci := dt.ColIndex(nm); ci != i {
+			t.Errorf("ReadCSV ColIndex(%s): %d != %d", nm, ci, i)
+		}
+	}
+
+	// round trip through emergent headers
+	var b strings.Builder
+	if err := dt.WriteCSV(&b, Tab, Headers); err != nil {
+		t.Fatal(err)
+	}
+	rt := &Table{}
+	if err := rt.ReadCSV(strings.NewReader(b.String()), Tab); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(rt.ColNames, hdr) {
+		t.Errorf("ReadCSV emergent headers column order: %v != %v", rt.ColNames, hdr)
+	}
+}
+
+func TestReadCSVRange(t *testing.T) {
+	csv := "Row,Name\n0,a\n1,b\n2,c\n3,d\n4,e\n"
+	dt := &Table{}
+	if err := dt.ReadCSVRange(strings.NewReader(csv), Comma, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if dt.Rows != 2 {
+		t.Fatalf("ReadCSVRange rows: %d != 2", dt.Rows)
+	}
+	if v := dt.CellFloat("Row", 0); v != 1 {
+		t.Errorf("ReadCSVRange first row: %v != 1", v)
+	}
+	if s := dt.CellString("Name", 1); s != "c" {
+		t.Errorf("ReadCSVRange last row: %q != c", s)
+	}
+
+	// rest of file, and window past the end
+	dt = &Table{}
+	if err := dt.ReadCSVRange(strings.NewReader(csv), Comma, 3, -1); err != nil {
+		t.Fatal(err)
+	}
+	if dt.Rows != 2 || dt.CellString("Name", 1) != "e" {
+		t.Errorf("ReadCSVRange rest: %d rows", dt.Rows)
+	}
+	dt = &Table{}
+	if err := dt.ReadCSVRange(strings.NewReader(csv), Comma, 10, 2); err != nil {
+		t.Fatal(err)
+	}
+	if dt.Rows != 0 || dt.NumCols() != 2 {
+		t.Errorf("ReadCSVRange past end: %d rows %d cols", dt.Rows, dt.NumCols())
+	}
+
+	// existing columns: plain header is skipped
+	if err := dt.ReadCSVRange(strings.NewReader(csv), Comma, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if dt.Rows != 1 || dt.CellString("Name", 0) != "a" {
+		t.Errorf("ReadCSVRange existing cols: %d rows", dt.Rows)
+	}
+}
+
+func TestReadCSVDuplicateCols(t *testing.T) {
+	dt := &Table{}
+	err := dt.ReadCSV(strings.NewReader("Name,Value,Value\na,1,2\n"), Comma)
+	if err == nil || !strings.Contains(err.Error(), `column named: "Value" already exists`) {
+		t.Errorf("ReadCSV: expected duplicate column names error: %v", err)
+	}
+	if dt.NumCols() != 3 || dt.CellFloat("Value", 0) != 1 {
+		t.Errorf("ReadCSV: data should still be read with duplicate names")
+	}
+}
+
+func TestReadCSVBOM(t *testing.T) {
+	csv := "\ufeffName,Value\r\na,1\r\n\"b\r\nc\",2\r\n"
+	fn := filepath.Join(t.TempDir(), "bom.csv")
+	if err := os.WriteFile(fn, []byte(csv), 0666); err != nil {
+		t.Fatal(err)
+	}
+	dt := &Table{}
+	if err := dt.OpenCSV(core.Filename(fn), Comma); err != nil {
+		t.Fatal(err)
+	}
+	if dt.ColNames[0] != "Name" || dt.ColNames[1] != "Value" {
+		t.Errorf("OpenCSV BOM: column names: %q", dt.ColNames)
+	}
+	if s := dt.CellString("Name", 0); s != "a" {
+		t.Errorf("OpenCSV CRLF: %q != a", s)
+	}
+	if s := dt.CellString("Name", 1); s != "b\nc" {
+		t.Errorf("OpenCSV CRLF in quoted value: %q", s)
+	}
+	if dt.CellFloat("Value", 1) != 2 {
+		t.Errorf("OpenCSV CRLF: value %v != 2", dt.CellFloat("Value", 1))
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Value", etensor.FLOAT64, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2, 2}, nil},
+	}, 3)
+	dt.SetMetaData("precision", "3")
+	for i := 0; i < dt.Rows; i++ {
+		dt.SetCellString("Name", i, "a|b")
+		dt.SetCellFloat("Value", i, 1.0/3.0)
+	}
+	var b strings.Builder
+	if err := dt.WriteMarkdown(&b, 0); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 2+dt.Rows {
+		t.Fatalf("WriteMarkdown: %d lines != %d:\n%s", len(lines), 2+dt.Rows, b.String())
+	}
+	if lines[0] != "| Name | Value | Input |" {
+		t.Errorf("WriteMarkdown header: %q", lines[0])
+	}
+	if lines[1] != "| --- | ---: | --- |" {
+		t.Errorf("WriteMarkdown separator: %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], `| a\|b | 0.333 | Float32: `) {
+		t.Errorf("WriteMarkdown row: %q", lines[2])
+	}
+
+	b.Reset()
+	if err := dt.WriteMarkdown(&b, 2); err != nil {
+		t.Fatal(err)
+	}
+	nrow := 0
+	for _, ln := range strings.Split(b.String(), "\n") {
+		if strings.HasPrefix(ln, "|") {
+			nrow++
+		}
+	}
+	if nrow != 2+2 {
+		t.Errorf("WriteMarkdown maxRows: %d table lines != 4:\n%s", nrow, b.String())
+	}
+	if !strings.Contains(b.String(), "2 of 3 rows shown") {
+		t.Errorf("WriteMarkdown maxRows: missing truncation note:\n%s", b.String())
+	}
+}
+
+func TestAppendCSV(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2, 2}, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+	}, 0)
+	addRows := func(n int) {
+		st := dt.Rows
+		dt.AddRows(n)
+		for ri := st; ri < dt.Rows; ri++ {
+			dt.SetCellString("Name", ri, "trl"+string(rune('0'+ri)))
+			dt.SetCellTensorFloat1D("Input", ri, ri%4, 1)
+			dt.SetCellFloat("Err", ri, float64(ri)/10)
+		}
+	}
+	fn := core.Filename(filepath.Join(t.TempDir(), "log.tsv"))
+	addRows(2)
+	next, err := dt.AppendCSV(fn, Tab, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 2 {
+		t.Errorf("AppendCSV: next row: %d != 2", next)
+	}
+	addRows(3)
+	next, err = dt.AppendCSV(fn, Tab, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 5 {
+		t.Errorf("AppendCSV: next row: %d != 5", next)
+	}
+
+	rt := &Table{}
+	if err := rt.OpenCSV(fn, Tab); err != nil {
+		t.Fatal(err)
+	}
+	if rt.Rows != dt.Rows || rt.NumCols() != dt.NumCols() {
+		t.Fatalf("AppendCSV: read back rows, cols: %d, %d != %d, %d", rt.Rows, rt.NumCols(), dt.Rows, dt.NumCols())
+	}
+	for ci, cl := range dt.Cols {
+		rc := rt.Cols[ci]
+		for i := 0; i < cl.Len(); i++ {
+			if rc.StringValue1D(i) != cl.StringValue1D(i) {
+				t.Errorf("AppendCSV: col: %s index: %d: %v != %v", dt.ColNames[ci], i, rc.StringValue1D(i), cl.StringValue1D(i))
+			}
+		}
+	}
+
+	ot := New(Schema{{"Other", etensor.FLOAT64, nil, nil}}, 1)
+	if _, err := ot.AppendCSV(fn, Tab, 0); err == nil {
+		t.Error("AppendCSV: expected error for mismatched headers")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"slices"
+
+	"github.com/emer/etable/v2/etensor"
+)
+
+// These *Locked methods use the Table Mu mutex to serialize writes
+// against reads, for tables that are written in one goroutine
+// (e.g., a training loop) and read in another (e.g., the GUI).
+// Writers take the write lock, and readers take the read lock.
+// The OnChange functions of the writes are called after the write lock
+// is released, so they can read the table via the *Locked methods.
+// For displaying such a table, SnapshotLocked returns a copy that
+// can then be read without any further locking.
+
+// lockWrite takes the write lock, deferring any OnChange functions
+// until unlockWrite.
+func (dt *Table) lockWrite() {
+	dt.Mu.Lock()
+	dt.deferChanges = true
+}
+
+// unlockWrite releases the write lock taken by lockWrite, and then calls
+// the OnChange functions if the table was changed, so that they can read
+// the table via the *Locked methods without deadlocking.
+func (dt *Table) unlockWrite() {
+	changed := dt.pendingChange
+	dt.deferChanges, dt.pendingChange = false, false
+	dt.Mu.Unlock()
+	if changed {
+		dt.callOnChanges()
+	}
+}
+
+// AddRowsLocked adds n rows to each of the columns, under the write lock.
+func (dt *Table) AddRowsLocked(n int) {
+	dt.lockWrite()
+	defer dt.unlockWrite()
+	dt.AddRows(n)
+}
+
+// SetNumRowsLocked sets the number of rows in the table, under the write lock.
+func (dt *Table) SetNumRowsLocked(rows int) {
+	dt.lockWrite()
+	defer dt.unlockWrite()
+	dt.SetNumRows(rows)
+}
+
+// SetCellFloatLocked sets the float64 value of cell at given column (by name),
+// row index for columns that have 1-dimensional tensors, under the write lock.
+// Returns true if set.
+func (dt *Table) SetCellFloatLocked(colNm string, row int, val float64) bool {
+	dt.lockWrite()
+	defer dt.unlockWrite()
+	return dt.SetCellFloat(colNm, row, val)
+}
+
+// SetCellStringLocked sets the string value of cell at given column (by name),
+// row index for columns that have 1-dimensional tensors, under the write lock.
+// Returns true if set.
+func (dt *Table) SetCellStringLocked(colNm string, row int, val string) bool {
+	dt.lockWrite()
+	defer dt.unlockWrite()
+	return dt.SetCellString(colNm, row, val)
+}
+
+// SetCellTensorLocked sets the tensor value of cell at given column (by name),
+// row index for columns that have n-dimensional tensors, under the write lock.
+// Returns true if set.
+func (dt *Table) SetCellTensorLocked(colNm string, row int, val etensor.Tensor) bool {
+	dt.lockWrite()
+	defer dt.unlockWrite()
+	return dt.SetCellTensor(colNm, row, val)
+}
+
+// CellFloatLocked returns the float64 value of cell at given column (by name),
+// row index for columns that have 1-dimensional tensors, under the read lock.
+// Returns NaN if column is not a 1-dimensional tensor or row not valid.
+func (dt *Table) CellFloatLocked(colNm string, row int) float64 {
+	dt.Mu.RLock()
+	defer dt.Mu.RUnlock()
+	return dt.CellFloat(colNm, row)
+}
+
+// CellStringLocked returns the string value of cell at given column (by name),
+// row index for columns that have 1-dimensional tensors, under the read lock.
+// Returns "" if column is not a 1-dimensional tensor or row not valid.
+func (dt *Table) CellStringLocked(colNm string, row int) string {
+	dt.Mu.RLock()
+	defer dt.Mu.RUnlock()
+	return dt.CellString(colNm, row)
+}
+
+// NumRowsLocked returns the number of rows, under the read lock.
+func (dt *Table) NumRowsLocked() int {
+	dt.Mu.RLock()
+	defer dt.Mu.RUnlock()
+	return dt.Rows
+}
+
+// SnapshotLocked returns a copy of the table, using the etensor.Tensor
+// Snapshot of each column, under the read lock.  The copy is internally
+// consistent and can be read without locking, e.g., for display in the GUI
+// of a table that is being written in another goroutine using the write lock.
+func (dt *Table) SnapshotLocked() *Table {
+	dt.Mu.RLock()
+	defer dt.Mu.RUnlock()
+	st := &Table{Rows: dt.Rows}
+	st.Cols = make([]etensor.Tensor, len(dt.Cols))
+	for i, cl := range dt.Cols {
+		st.Cols[i] = cl.Snapshot()
+	}
+	st.ColNames = slices.Clone(dt.ColNames)
+	st.UpdateColNameMap()
+	st.CopyMetaDataFrom(dt)
+	return st
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"github.com/emer/etable/v2/etensor"
+)
+
+// NewPooled returns a new Table constructed from given Schema, as in New,
+// except that the FLOAT64 and FLOAT32 columns are obtained from the
+// etensor tensor pools (see etensor.GetPooled), re-using the columns of
+// tables with the same column sizes that were returned with Recycle.
+// This is for training loops that create and discard many small tables,
+// to reduce allocation and GC pressure: it is strictly opt-in, and tables
+// made with New are never affected.  The resulting table is equivalent
+// to one made with New, with all values zero.  See Recycle for the rules
+// on when the columns can be returned to the pool.
+func NewPooled(sc Schema, rows int) *Table {
+	dt := &Table{}
+	dt.setFromSchema(sc, rows, true)
+	return dt
+}
+
+// newPooledCol returns a new column tensor of given type, shape and
+// dimension names, from the etensor pools for FLOAT64 and FLOAT32.
+func newPooledCol(typ etensor.Type, sh []int, dn []string) etensor.Tensor {
+	switch typ {
+	case etensor.FLOAT64:
+		tsr := etensor.GetPooled(sh)
+		copy(tsr.Nms, dn)
+		return tsr
+	case etensor.FLOAT32:
+		tsr := etensor.GetPooledFloat32(sh)
+		copy(tsr.Nms, dn)
+		return tsr
+	}
+	return etensor.New(typ, sh, nil, dn)
+}
+
+// Recycle returns the FLOAT64 and FLOAT32 columns of the table to the
+// etensor tensor pools, for re-use by a subsequent NewPooled (or
+// etensor.GetPooled) with the same column sizes, and resets the table to
+// have no columns or rows.  The table can be re-used, e.g., with
+// SetFromSchema, but its former columns can not.  It is up to the caller
+// to ensure that nothing else still refers to the column tensors, as they
+// will be handed out to, and overwritten by, other tables:
+//   - any tensor obtained from the table, e.g., by ColByName, CellTensor,
+//     or SubSpace of a column, must no longer be used;
+//   - columns shared with another table, e.g., by AddCol of a column of
+//     this table, must not be recycled (use Clone to get an unshared copy);
+//   - the table must not be in use by an IndexView, Splits, or a GUI view,
+//     e.g., an etview.TableView or eplot.Plot2D.
+//
+// Columns of any other type are left to the GC as usual.
+func (dt *Table) Recycle() {
+	for _, cl := range dt.Cols {
+		etensor.PutPooled(cl)
+	}
+	dt.Cols = nil
+	dt.ColNames = nil
+	dt.Rows = 0
+	dt.UpdateColNameMap()
+	dt.Changed()
+}
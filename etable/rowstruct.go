@@ -0,0 +1,125 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/emer/etable/v2/etensor"
+)
+
+// rowField is a struct field mapped to a column, for ScanRow and SetRow
+type rowField struct {
+	field reflect.Value
+	name  string
+	col   etensor.Tensor
+}
+
+// rowFields returns the fields of given struct value that are tagged with
+// an etable:"ColName" column name, along with the corresponding columns,
+// checking that the column exists, has scalar (1D) cells, and is compatible
+// with the field type: float and int fields require a numeric column,
+// and string fields can be used with any column.
+func (dt *Table) rowFields(sv reflect.Value, fnm string) ([]rowField, error) {
+	st := sv.Type()
+	var rfs []rowField
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		cn := sf.Tag.Get("etable")
+		if cn == "" || cn == "-" || !sf.IsExported() {
+			continue
+		}
+		ci, err := dt.ColIndexTry(cn)
+		if err != nil {
+			return nil, fmt.Errorf("etable.Table.%s: field: %s: %w", fnm, sf.Name, err)
+		}
+		col := dt.Cols[ci]
+		if col.NumDims() != 1 {
+			return nil, fmt.Errorf("etable.Table.%s: field: %s: column: %q does not have scalar cells", fnm, sf.Name, cn)
+		}
+		switch sf.Type.Kind() {
+		case reflect.String:
+		case reflect.Float32, reflect.Float64,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if col.DataType() == etensor.STRING {
+				return nil, fmt.Errorf("etable.Table.%s: field: %s of type: %v is not compatible with String column: %q", fnm, sf.Name, sf.Type, cn)
+			}
+		default:
+			return nil, fmt.Errorf("etable.Table.%s: field: %s of type: %v is not supported -- must be a float, int, or string", fnm, sf.Name, sf.Type)
+		}
+		rfs = append(rfs, rowField{field: sv.Field(i), name: sf.Name, col: col})
+	}
+	return rfs, nil
+}
+
+// ScanRow sets the fields of given pointer to a struct from the values
+// in given row of the table, for each field tagged with the name of the
+// column to use, e.g., `etable:"Subject"`.  Float and int fields can be
+// used with numeric columns, and string fields with any column.
+// Only columns with scalar (1D) cells are supported.  Returns an error
+// if the row is not valid, a tagged column is not found, or a field type
+// is not compatible with its column, in which case no fields are set.
+func (dt *Table) ScanRow(row int, dst any) error {
+	sv := reflect.ValueOf(dst)
+	if sv.Kind() != reflect.Pointer || sv.IsNil() || sv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("etable.Table.ScanRow: dst must be a non-nil pointer to a struct, not: %T", dst)
+	}
+	if err := dt.IsValidRowTry(row); err != nil {
+		return err
+	}
+	rfs, err := dt.rowFields(sv.Elem(), "ScanRow")
+	if err != nil {
+		return err
+	}
+	for _, rf := range rfs {
+		switch rf.field.Kind() {
+		case reflect.String:
+			rf.field.SetString(rf.col.StringValue1D(row))
+		case reflect.Float32, reflect.Float64:
+			rf.field.SetFloat(rf.col.FloatValue1D(row))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			rf.field.SetInt(int64(rf.col.FloatValue1D(row)))
+		default: // uint
+			rf.field.SetUint(uint64(rf.col.FloatValue1D(row)))
+		}
+	}
+	return nil
+}
+
+// SetRow sets the values in given row of the table from the fields of
+// given struct, or pointer to a struct, for each field tagged with the
+// name of the column to set, e.g., `etable:"Subject"`, as in ScanRow.
+// Returns an error if the row is not valid, a tagged column is not found,
+// or a field type is not compatible with its column, in which case
+// no values are set.
+func (dt *Table) SetRow(row int, src any) error {
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("etable.Table.SetRow: src must be a struct or pointer to a struct, not: %T", src)
+	}
+	if err := dt.IsValidRowTry(row); err != nil {
+		return err
+	}
+	rfs, err := dt.rowFields(sv, "SetRow")
+	if err != nil {
+		return err
+	}
+	for _, rf := range rfs {
+		switch rf.field.Kind() {
+		case reflect.String:
+			rf.col.SetString1D(row, rf.field.String())
+		case reflect.Float32, reflect.Float64:
+			rf.col.SetFloat1D(row, rf.field.Float())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			rf.col.SetFloat1D(row, float64(rf.field.Int()))
+		default: // uint
+			rf.col.SetFloat1D(row, float64(rf.field.Uint()))
+		}
+	}
+	dt.Changed()
+	return nil
+}
@@ -4,7 +4,12 @@
 
 package etable
 
-import "github.com/emer/etable/v2/etensor"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/emer/etable/v2/etensor"
+)
 
 // Column specifies everything about a column -- can be used for constructing tables
 type Column struct {
@@ -25,3 +30,99 @@ type Column struct {
 // Schema specifies all of the columns of a table, sufficient to create the table.
 // It is just a slice list of Columns
 type Schema []Column
+
+// Equals returns true if the other schema has exactly the same columns,
+// in the same order, including the DimNames.
+func (sc Schema) Equals(other Schema) bool {
+	if ok, _ := sc.Compatible(other); !ok {
+		return false
+	}
+	for i := range sc {
+		if !slices.Equal(sc[i].DimNames, other[i].DimNames) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compatible returns true if the other schema has the same number of
+// columns, with the same names, types and cell shapes in the same order,
+// such that rows of a table with one schema can be copied into a table
+// with the other, e.g., when concatenating tables.  DimNames are not
+// compared.  If not compatible, it returns a human-readable reason for
+// the first difference found.
+func (sc Schema) Compatible(other Schema) (bool, string) {
+	if len(sc) != len(other) {
+		return false, fmt.Sprintf("number of columns: %d != %d", len(sc), len(other))
+	}
+	for i := range sc {
+		c, o := &sc[i], &other[i]
+		switch {
+		case c.Name != o.Name:
+			return false, fmt.Sprintf("column %d: name %q != %q", i, c.Name, o.Name)
+		case c.Type != o.Type:
+			return false, fmt.Sprintf("column %d (%s): type %s != %s", i, c.Name, c.Type, o.Type)
+		case !slices.Equal(c.CellShape, o.CellShape):
+			return false, fmt.Sprintf("column %d (%s): cell shape %v != %v", i, c.Name, c.CellShape, o.CellShape)
+		}
+	}
+	return true, ""
+}
+
+// NewSchema returns a new empty Schema, to which columns can be added
+// using the Add* methods, e.g.:
+//
+//	sc := etable.NewSchema().AddString("Name").AddInt("Trial").AddFloat64("Act", []int{10, 10})
+func NewSchema() Schema {
+	return Schema{}
+}
+
+// AddCol returns the schema with a column of given name, type and cell
+// shape (nil for scalar columns) added at the end.  If no dimNames are
+// given, default names are used for 1, 2 and 4 dimensional cell shapes
+// (see DefaultDimNames).
+func (sc Schema) AddCol(name string, typ etensor.Type, cellShape []int, dimNames ...string) Schema {
+	if len(dimNames) == 0 {
+		dimNames = DefaultDimNames(len(cellShape))
+	}
+	return append(sc, Column{Name: name, Type: typ, CellShape: cellShape, DimNames: dimNames})
+}
+
+// AddFloat64 returns the schema with a FLOAT64 column of given name
+// and cell shape (nil for a scalar column) added at the end.
+func (sc Schema) AddFloat64(name string, cellShape []int) Schema {
+	return sc.AddCol(name, etensor.FLOAT64, cellShape)
+}
+
+// AddFloat32 returns the schema with a FLOAT32 column of given name
+// and cell shape (nil for a scalar column) added at the end.
+func (sc Schema) AddFloat32(name string, cellShape []int) Schema {
+	return sc.AddCol(name, etensor.FLOAT32, cellShape)
+}
+
+// AddInt returns the schema with a scalar INT column of given name
+// added at the end.
+func (sc Schema) AddInt(name string) Schema {
+	return sc.AddCol(name, etensor.INT, nil)
+}
+
+// AddString returns the schema with a scalar STRING column of given name
+// added at the end.
+func (sc Schema) AddString(name string) Schema {
+	return sc.AddCol(name, etensor.STRING, nil)
+}
+
+// DefaultDimNames returns the default dimension names for a cell shape
+// with given number of dimensions: X for 1, Y, X for 2, and
+// PoolY, PoolX, NeurY, NeurX for 4, and nil otherwise.
+func DefaultDimNames(ndims int) []string {
+	switch ndims {
+	case 1:
+		return []string{"X"}
+	case 2:
+		return []string{"Y", "X"}
+	case 4:
+		return []string{"PoolY", "PoolX", "NeurY", "NeurX"}
+	}
+	return nil
+}
@@ -6,8 +6,11 @@ package etable
 
 import (
 	"math/rand"
+	"slices"
 	"sort"
 	"testing"
+
+	"github.com/emer/etable/v2/etensor"
 )
 
 type TstSort struct {
@@ -46,3 +49,33 @@ func TestSort(t *testing.T) {
 		}
 	}
 }
+
+func TestSortColNatural(t *testing.T) {
+	ids := []string{"2", "10", "1", "item10", "item2"}
+	dt := New(Schema{
+		{"ID", etensor.STRING, nil, nil},
+	}, len(ids))
+	for i, id := range ids {
+		dt.SetCellString("ID", i, id)
+	}
+	vals := func(ix *IndexView) []string {
+		vs := make([]string, ix.Len())
+		for i, r := range ix.Indexes {
+			vs[i] = dt.CellString("ID", r)
+		}
+		return vs
+	}
+	ix := NewIndexView(dt)
+	ix.SortCol(0, Ascending)
+	if vs := vals(ix); !slices.Equal(vs, []string{"1", "10", "2", "item10", "item2"}) {
+		t.Errorf("SortCol lexicographic: %v", vs)
+	}
+	ix.SortColNatural(0, Ascending)
+	if vs := vals(ix); !slices.Equal(vs, []string{"1", "2", "10", "item2", "item10"}) {
+		t.Errorf("SortColNatural: %v", vs)
+	}
+	ix.SortColNatural(0, Descending)
+	if vs := vals(ix); !slices.Equal(vs, []string{"item10", "item2", "10", "2", "1"}) {
+		t.Errorf("SortColNatural descending: %v", vs)
+	}
+}
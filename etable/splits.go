@@ -256,6 +256,7 @@ func (spl *Splits) ExtractLevels(levels []int) (*Splits, error) {
 			ss.Values[si] = slices.Clone(curValues)
 		} else {
 			curIx.Indexes = append(curIx.Indexes, ss.Splits[si].Indexes...) // absorb
+			curIx.IndexesChanged()
 			ss.Delete(si)
 		}
 	}
@@ -344,7 +345,7 @@ func (spl *Splits) AggByNameTry(name string) (*SplitAgg, error) {
 	if ag != nil {
 		return ag, nil
 	}
-	return nil, fmt.Errorf("etable.Splits AggByNameTry: agg results named: %v not found", name)
+	return nil, fmt.Errorf("etable.Splits AggByNameTry: agg results named: %v: %w", name, ErrAggNotFound)
 }
 
 // AggByColName returns Agg results for given column name, optionally including :Name agg name
@@ -380,7 +381,7 @@ func (spl *Splits) AggByColNameTry(name string) (*SplitAgg, error) {
 	if ag != nil {
 		return ag, nil
 	}
-	return nil, fmt.Errorf("etable.Splits AggByColNameTry: agg results named: %v not found", name)
+	return nil, fmt.Errorf("etable.Splits AggByColNameTry: agg results named: %v: %w", name, ErrAggNotFound)
 }
 
 // SetLevels sets the Levels index names -- must match actual index dimensionality
@@ -443,6 +444,23 @@ func (spl *Splits) AggsToTable(colName bool) *Table {
 	return st
 }
 
+// AggsToTableN returns a Table containing this Splits' aggregate data,
+// as in AggsToTable, with an additional "N" Int column at the end
+// with the number of rows in each split, e.g., to report the group
+// sizes along with the means.
+func (spl *Splits) AggsToTableN(colName bool) *Table {
+	st := spl.AggsToTable(colName)
+	if st == nil {
+		return nil
+	}
+	nc := etensor.NewInt([]int{st.Rows}, nil, []string{"row"})
+	for si, sp := range spl.Splits {
+		nc.Values[si] = len(sp.Indexes)
+	}
+	st.AddCol(nc, "N")
+	return st
+}
+
 // AggsToTableCopy returns a Table containing this Splits' aggregate data
 // and a copy of the first row of data for each split for all non-agg cols,
 // which is useful for recording other data that goes along with aggregated values.
@@ -0,0 +1,205 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"cogentcore.org/core/reflectx"
+	"github.com/emer/etable/v2/etensor"
+)
+
+// WriteSQL creates a new SQL table of given name in given database,
+// and inserts all of the rows of this table into it.  Any SQL driver can
+// be used, e.g., an in-memory SQLite database, as long as it supports
+// ? placeholders for parameters.  Column types are mapped to SQL types:
+// floats to REAL, ints and bool to INTEGER, and strings to TEXT.
+// Columns with n-dimensional cells are written as TEXT containing
+// the JSON array of cell values.  Null cells (see etensor.Tensor IsNull1D)
+// of 1D columns are written as NULL.
+func (dt *Table) WriteSQL(db *sql.DB, tableName string) error {
+	if dt.NumCols() == 0 {
+		return fmt.Errorf("etable.Table WriteSQL: no columns in table")
+	}
+	_, err := db.Exec(dt.sqlCreate(tableName))
+	if err != nil {
+		return err
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	st, err := tx.Prepare(dt.sqlInsert(tableName))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer st.Close()
+	vals := make([]any, dt.NumCols())
+	for row := 0; row < dt.Rows; row++ {
+		for ci, cl := range dt.Cols {
+			vals[ci], err = sqlCellValue(cl, row)
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		if _, err = st.Exec(vals...); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ReadSQL returns a new Table from the results of given SQL query
+// on given database.  Column types are set from the SQL column types:
+// INTEGER types to INT64, REAL / FLOAT / DOUBLE / NUMERIC types to FLOAT64,
+// and all others to STRING.  NULL values are marked as null cells
+// with SetNull1D, with a value of NaN for floats, and 0 or empty strings
+// otherwise.
+// Note that n-dimensional cells written as JSON text by WriteSQL are read
+// as STRING columns.  Duplicate column names in the results, e.g., from
+// a join, are returned as an error, after reading the full table.
+func ReadSQL(db *sql.DB, query string) (*Table, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	sc := make(Schema, len(cts))
+	for ci, ct := range cts {
+		sc[ci] = Column{Name: ct.Name(), Type: sqlTypeToType(ct.DatabaseTypeName())}
+	}
+	dt := &Table{}
+	colErr := dt.setFromSchemaTry(sc, 0)
+	vals := make([]any, len(cts))
+	ptrs := make([]any, len(cts))
+	for ci := range vals {
+		ptrs[ci] = &vals[ci]
+	}
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return dt, err
+		}
+		row := dt.Rows
+		dt.AddRows(1)
+		for ci, v := range vals {
+			switch x := v.(type) {
+			case nil:
+				dt.Cols[ci].SetNull1D(row, true)
+				if sc[ci].Type == etensor.FLOAT64 {
+					dt.Cols[ci].SetFloat1D(row, math.NaN())
+				}
+				continue
+			case []byte:
+				v = string(x)
+			}
+			if sc[ci].Type == etensor.STRING {
+				dt.SetCellStringIndex(ci, row, reflectx.ToString(v))
+			} else {
+				fv, _ := reflectx.ToFloat(v)
+				dt.SetCellFloatIndex(ci, row, fv)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return dt, err
+	}
+	return dt, colErr
+}
+
+// sqlCreate returns the SQL CREATE TABLE statement for this table
+func (dt *Table) sqlCreate(tableName string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %q (", tableName)
+	for ci, cl := range dt.Cols {
+		if ci > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%q %s", dt.ColNames[ci], sqlType(cl))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// sqlInsert returns the SQL INSERT statement for this table, with ? placeholders
+func (dt *Table) sqlInsert(tableName string) string {
+	nms := make([]string, dt.NumCols())
+	for ci, nm := range dt.ColNames {
+		nms[ci] = fmt.Sprintf("%q", nm)
+	}
+	phs := strings.TrimSuffix(strings.Repeat("?, ", dt.NumCols()), ", ")
+	return fmt.Sprintf("INSERT INTO %q (%s) VALUES (%s)", tableName, strings.Join(nms, ", "), phs)
+}
+
+// sqlType returns the SQL type for given column tensor
+func sqlType(cl etensor.Tensor) string {
+	if cl.NumDims() > 1 {
+		return "TEXT"
+	}
+	switch cl.DataType() {
+	case etensor.FLOAT32, etensor.FLOAT64:
+		return "REAL"
+	case etensor.STRING:
+		return "TEXT"
+	}
+	return "INTEGER"
+}
+
+// sqlTypeToType returns the etensor type for given SQL database type name
+func sqlTypeToType(st string) etensor.Type {
+	st = strings.ToUpper(st)
+	switch {
+	case strings.Contains(st, "INT"):
+		return etensor.INT64
+	case strings.Contains(st, "REAL"), strings.Contains(st, "FLOA"), strings.Contains(st, "DOUB"), strings.Contains(st, "NUMERIC"), strings.Contains(st, "DECIMAL"):
+		return etensor.FLOAT64
+	}
+	return etensor.STRING
+}
+
+// sqlCellValue returns the value for given column and row for SQL insertion,
+// with n-dimensional cells encoded as a JSON array, and nil for null 1D cells.
+func sqlCellValue(cl etensor.Tensor, row int) (any, error) {
+	if cl.NumDims() == 1 {
+		switch {
+		case cl.IsNull1D(row):
+			return nil, nil
+		case cl.DataType() == etensor.STRING:
+			return cl.StringValue1D(row), nil
+		case cl.DataType() == etensor.FLOAT32 || cl.DataType() == etensor.FLOAT64:
+			return cl.FloatValue1D(row), nil
+		default:
+			return int64(cl.FloatValue1D(row)), nil
+		}
+	}
+	_, csz := cl.RowCellSize()
+	off := row * csz
+	var vals any
+	if cl.DataType() == etensor.STRING {
+		sv := make([]string, csz)
+		for i := range sv {
+			sv[i] = cl.StringValue1D(off + i)
+		}
+		vals = sv
+	} else {
+		fv := make([]float64, csz)
+		for i := range fv {
+			fv[i] = cl.FloatValue1D(off + i)
+		}
+		vals = fv
+	}
+	b, err := json.Marshal(vals)
+	return string(b), err
+}
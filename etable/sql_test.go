@@ -0,0 +1,123 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etable
+
+import (
+	"database/sql"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/emer/etable/v2/etensor"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLStatements(t *testing.T) {
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2}, nil},
+	}, 1)
+	dt.SetCellString("Name", 0, "a")
+	dt.SetCellFloat("Epoch", 0, 3)
+	dt.SetCellFloat("Err", 0, 0.5)
+	dt.SetCellTensorFloat1D("Input", 0, 1, 2)
+
+	ex := `CREATE TABLE "log" ("Name" TEXT, "Epoch" INTEGER, "Err" REAL, "Input" TEXT)`
+	if cs := dt.sqlCreate("log"); cs != ex {
+		t.Errorf("sqlCreate: %s != %s", cs, ex)
+	}
+	ex = `INSERT INTO "log" ("Name", "Epoch", "Err", "Input") VALUES (?, ?, ?, ?)`
+	if is := dt.sqlInsert("log"); is != ex {
+		t.Errorf("sqlInsert: %s != %s", is, ex)
+	}
+	exv := []any{"a", int64(3), 0.5, "[0,2]"}
+	for ci, cl := range dt.Cols {
+		v, err := sqlCellValue(cl, 0)
+		if err != nil {
+			t.Error(err)
+		}
+		if v != exv[ci] {
+			t.Errorf("sqlCellValue col %d: %v != %v", ci, v, exv[ci])
+		}
+	}
+	types := map[string]etensor.Type{"INTEGER": etensor.INT64, "BIGINT": etensor.INT64, "REAL": etensor.FLOAT64, "double": etensor.FLOAT64, "TEXT": etensor.STRING, "": etensor.STRING}
+	for st, tp := range types {
+		if sqlTypeToType(st) != tp {
+			t.Errorf("sqlTypeToType %q: %v != %v", st, sqlTypeToType(st), tp)
+		}
+	}
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Ping(); err != nil {
+		t.Skipf("SQLite driver not available (requires cgo): %v", err)
+	}
+	db.SetMaxOpenConns(1) // each connection has its own in-memory database
+	dt := New(Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Err", etensor.FLOAT64, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2}, nil},
+	}, 3)
+	for row := 0; row < 3; row++ {
+		dt.SetCellString("Name", row, strconv.Itoa(row))
+		dt.SetCellFloat("Epoch", row, float64(row+1))
+		dt.SetCellFloat("Err", row, 0.5*float64(row))
+		dt.SetCellTensorFloat1D("Input", row, 1, float64(row))
+	}
+	dt.ColByName("Name").SetNull1D(2, true)
+	dt.ColByName("Epoch").SetNull1D(2, true)
+	dt.ColByName("Err").SetNull1D(1, true)
+	if err := dt.WriteSQL(db, "log"); err != nil {
+		t.Fatal(err)
+	}
+	rt, err := ReadSQL(db, `SELECT * FROM "log"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt.Rows != 3 || rt.NumCols() != 4 {
+		t.Fatalf("ReadSQL: rows %d cols %d != 3, 4", rt.Rows, rt.NumCols())
+	}
+	types := []etensor.Type{etensor.STRING, etensor.INT64, etensor.FLOAT64, etensor.STRING}
+	for ci, tp := range types {
+		if rt.Cols[ci].DataType() != tp {
+			t.Errorf("ReadSQL col %s: type %v != %v", rt.ColNames[ci], rt.Cols[ci].DataType(), tp)
+		}
+	}
+	if s := rt.CellString("Name", 1); s != "1" {
+		t.Errorf("ReadSQL Name[1]: %q != 1", s)
+	}
+	if v := rt.CellFloat("Epoch", 1); v != 2 {
+		t.Errorf("ReadSQL Epoch[1]: %v != 2", v)
+	}
+	if v := rt.CellFloat("Err", 2); v != 1 {
+		t.Errorf("ReadSQL Err[2]: %v != 1", v)
+	}
+	if s := rt.CellString("Input", 2); s != "[0,2]" {
+		t.Errorf("ReadSQL Input[2]: %q != [0,2]", s)
+	}
+	nulls := []struct {
+		col string
+		row int
+	}{{"Name", 2}, {"Epoch", 2}, {"Err", 1}}
+	for _, nl := range nulls {
+		if !rt.ColByName(nl.col).IsNull1D(nl.row) {
+			t.Errorf("ReadSQL %s[%d]: NULL not read as null", nl.col, nl.row)
+		}
+	}
+	if rt.ColByName("Err").IsNull1D(0) || rt.ColByName("Name").IsNull1D(1) {
+		t.Errorf("ReadSQL: non-NULL values read as null")
+	}
+	if v := rt.CellFloat("Err", 1); !math.IsNaN(v) {
+		t.Errorf("ReadSQL Err[1]: NULL float %v is not NaN", v)
+	}
+}
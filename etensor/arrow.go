@@ -3,3 +3,47 @@
 // license that can be found in the LICENSE file.
 
 package etensor
+
+import (
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/emer/etable/v2/bitslice"
+)
+
+// ArrowValids returns the arrow validity flags corresponding to given
+// nulls bitslice, for use in arrow builder AppendValues calls:
+// arrow flags valid values as true, whereas Nulls flags missing values.
+// Returns nil if nulls is nil, which arrow treats as all valid.
+func ArrowValids(nulls bitslice.Slice) []bool {
+	if nulls == nil {
+		return nil
+	}
+	vld := nulls.ToBools()
+	for i := range vld {
+		vld[i] = !vld[i]
+	}
+	return vld
+}
+
+// NullsFromArrow returns a nulls bitslice reconstructed from the
+// validity bitmap of given arrow data.  Returns nil if there
+// are no nulls in the data.
+func NullsFromArrow(dat *array.Data) bitslice.Slice {
+	if dat == nil || dat.NullN() == 0 {
+		return nil
+	}
+	bufs := dat.Buffers()
+	if len(bufs) == 0 || bufs[0] == nil {
+		return nil
+	}
+	vbm := bufs[0].Bytes()
+	n := dat.Len()
+	off := dat.Offset()
+	nulls := bitslice.Make(n, 0)
+	for i := 0; i < n; i++ {
+		if !bitutil.BitIsSet(vbm, off+i) {
+			nulls.Set(i, true)
+		}
+	}
+	return nulls
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import "testing"
+
+func TestArrowNulls(t *testing.T) {
+	nulls := map[int]bool{1: true, 4: true, 5: true}
+
+	ft := NewFloat64([]int{2, 3}, nil, nil)
+	ft.SetFloats([]float64{1, 2, 3, 4, 5, 6})
+	for i := range nulls {
+		ft.SetNull1D(i, true)
+	}
+	ff := &Float64{}
+	ff.FromArrow(ft.ToArrow(), true)
+	for i := 0; i < ft.Len(); i++ {
+		if ff.IsNull1D(i) != nulls[i] {
+			t.Errorf("Float64 arrow nulls at %d: %v != %v", i, ff.IsNull1D(i), nulls[i])
+		}
+	}
+	if ff.Value1D(2) != 3 {
+		t.Errorf("Float64 arrow value at 2: %v != 3", ff.Value1D(2))
+	}
+
+	it := NewInt([]int{6}, nil, nil)
+	for i := range nulls {
+		it.SetNull1D(i, true)
+	}
+	fi := &Int{}
+	fi.FromArrow(it.ToArrow(), false)
+	for i := 0; i < it.Len(); i++ {
+		if fi.IsNull1D(i) != nulls[i] {
+			t.Errorf("Int arrow nulls at %d: %v != %v", i, fi.IsNull1D(i), nulls[i])
+		}
+	}
+
+	nt := NewFloat32([]int{3}, nil, nil)
+	f32 := &Float32{}
+	f32.FromArrow(nt.ToArrow(), true)
+	if f32.Nulls != nil {
+		t.Errorf("Float32 arrow nulls should be nil without any nulls")
+	}
+}
@@ -116,6 +116,25 @@ func (tsr *Bits) SetFloat1D(off int, val float64) {
 	tsr.Values.Set(off, Float64ToBool(val))
 }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Bits) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return BoolToFloat64(tsr.Values.Index(off)), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Bits) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values.Set(off, Float64ToBool(val))
+	return nil
+}
+
 func (tsr *Bits) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return BoolToFloat64(tsr.Values.Index(row*sz + cell))
@@ -179,6 +198,11 @@ func (tsr *Bits) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange is not applicable to bool tensors, and returns 0, 0
+func (tsr *Bits) RowRange(row int) (min, max float64) {
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -230,6 +254,32 @@ func (tsr *Bits) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Bits) Snapshot() Tensor {
+	vals := tsr.Values // read once
+	csr := NewBitsShape(&tsr.Shape)
+	csr.Values = snapshotBits(vals, csr.Len())
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
+// snapshotBits returns a copy of given bits with given length,
+// copying as many bits as are available, for Snapshot.
+func snapshotBits(bs bitslice.Slice, n int) bitslice.Slice {
+	sb := bitslice.Make(n, 0)
+	mn := min(n, bs.Len())
+	for i := 0; i < mn; i++ {
+		sb.Set(i, bs.Index(i))
+	}
+	return sb
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -275,6 +325,18 @@ func (tsr *Bits) SetShape(shape, strides []int, names []string) {
 	tsr.Values.SetLen(nln)
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Bits) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Bits) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -310,6 +372,12 @@ func (tsr *Bits) T() mat.Matrix {
 	return mat.Transpose{tsr}
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the packed bit Values, for memory profiling.
+func (tsr *Bits) SizeBytes() int {
+	return len(tsr.Values)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Bits) Label() string {
 	return fmt.Sprintf("Bits: %s", tsr.Shape.String())
@@ -0,0 +1,94 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"math"
+
+	"github.com/emer/etable/v2/bitslice"
+)
+
+// Clip clamps all of the values in given tensor into the [min, max] range,
+// in place, using float64 conversions.  NaN and Null values are left as is,
+// as are String tensors.  For integer tensors, the bounds are rounded inward
+// to the nearest integers (e.g., [.5, 7.5] -> [1, 7]), so that the clipped
+// values remain within range.
+func Clip(tsr Tensor, min, max float64) {
+	clip(tsr, min, max, true, true)
+}
+
+// ClipLower clamps all of the values in given tensor to be >= min,
+// in place -- see Clip for details.
+func ClipLower(tsr Tensor, min float64) {
+	clip(tsr, min, 0, true, false)
+}
+
+// ClipUpper clamps all of the values in given tensor to be <= max,
+// in place -- see Clip for details.
+func ClipUpper(tsr Tensor, max float64) {
+	clip(tsr, 0, max, false, true)
+}
+
+// clip does Clip with optional lower and upper bounds, using the
+// type-specialized clipValues for Float32, Float64 and Int tensors,
+// and the Tensor interface otherwise.
+func clip(tsr Tensor, min, max float64, lower, upper bool) {
+	typ := tsr.DataType()
+	if typ == STRING {
+		return
+	}
+	if typ != FLOAT32 && typ != FLOAT64 {
+		min = math.Ceil(min)
+		max = math.Floor(max)
+	}
+	switch t := tsr.(type) {
+	case *Float32:
+		clipValues(t.Values, t.Nulls, float32(min), float32(max), lower, upper)
+	case *Float64:
+		clipValues(t.Values, t.Nulls, min, max, lower, upper)
+	case *Int:
+		lower = lower && min >= math.MinInt64 // out of range bounds, e.g., -Inf
+		upper = upper && max <= math.MaxInt64
+		clipValues(t.Values, t.Nulls, int(min), int(max), lower, upper)
+	default:
+		clipTensor(tsr, min, max, lower, upper)
+	}
+}
+
+// clipTensor does clip through the float64 Tensor interface methods,
+// for any tensor type.
+func clipTensor(tsr Tensor, min, max float64, lower, upper bool) {
+	ln := tsr.Len()
+	for i := 0; i < ln; i++ {
+		if tsr.IsNull1D(i) {
+			continue
+		}
+		v := tsr.FloatValue1D(i)
+		switch {
+		case math.IsNaN(v):
+		case lower && v < min:
+			tsr.SetFloat1D(i, min)
+		case upper && v > max:
+			tsr.SetFloat1D(i, max)
+		}
+	}
+}
+
+// clipValues does clip directly on the values of a tensor of given type,
+// skipping Null values.  NaN values are skipped because all comparisons
+// with NaN are false.
+func clipValues[T Numeric](vals []T, nulls bitslice.Slice, min, max T, lower, upper bool) {
+	for i, v := range vals {
+		if nulls != nil && nulls.Index(i) {
+			continue
+		}
+		switch {
+		case lower && v < min:
+			vals[i] = min
+		case upper && v > max:
+			vals[i] = max
+		}
+	}
+}
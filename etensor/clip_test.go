@@ -0,0 +1,131 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClip(t *testing.T) {
+	ft := NewFloat64([]int{5}, nil, nil)
+	ft.Values = []float64{-2, .25, .5, 3, math.NaN()}
+	Clip(ft, 0, 1)
+	exp := []float64{0, .25, .5, 1}
+	for i, e := range exp {
+		if ft.Values[i] != e {
+			t.Errorf("Clip Float64 %d: %v != %v", i, ft.Values[i], e)
+		}
+	}
+	if !math.IsNaN(ft.Values[4]) {
+		t.Error("Clip should skip NaN")
+	}
+
+	it := NewInt([]int{4}, nil, nil)
+	it.Values = []int{-3, 1, 5, 9}
+	Clip(it, .5, 7.5) // rounded inward to [1, 7]
+	iexp := []int{1, 1, 5, 7}
+	for i, e := range iexp {
+		if it.Values[i] != e {
+			t.Errorf("Clip Int %d: %v != %v", i, it.Values[i], e)
+		}
+	}
+
+	ft.Values = []float64{-2, .5, 3, 1, 0}
+	ClipLower(ft, 0)
+	if ft.Values[0] != 0 || ft.Values[2] != 3 {
+		t.Errorf("ClipLower: %v", ft.Values)
+	}
+	ClipUpper(ft, 1)
+	if ft.Values[2] != 1 || ft.Values[1] != .5 {
+		t.Errorf("ClipUpper: %v", ft.Values)
+	}
+}
+
+func TestClipMethod(t *testing.T) {
+	ft := NewFloat32([]int{4}, nil, nil)
+	ft.Values = []float32{-1, .5, 2, 0}
+	ft.SetNull1D(3, true)
+	ft.Values[3] = -5
+	ft.Clip(0, 1)
+	if ft.Values[0] != 0 || ft.Values[1] != .5 || ft.Values[2] != 1 || ft.Values[3] != -5 {
+		t.Errorf("Float32.Clip: %v", ft.Values)
+	}
+	ut := NewUint8([]int{3}, nil, nil)
+	ut.Values = []uint8{0, 100, 250}
+	ut.Clip(10, 200)
+	if ut.Values[0] != 10 || ut.Values[1] != 100 || ut.Values[2] != 200 {
+		t.Errorf("Uint8.Clip: %v", ut.Values)
+	}
+	f64 := NewFloat64FromSlice([]float64{-3, 3}, nil)
+	f64.Clip(-1, 1)
+	if f64.Values[0] != -1 || f64.Values[1] != 1 {
+		t.Errorf("Float64.Clip: %v", f64.Values)
+	}
+}
+
+func TestClipTyped(t *testing.T) {
+	vals := []float64{-3, -.5, 0, .25, 2, 7, math.NaN(), -9}
+	tsrs := []Tensor{
+		NewFloat32([]int{len(vals)}, nil, nil),
+		NewFloat64([]int{len(vals)}, nil, nil),
+		NewInt([]int{len(vals)}, nil, nil),
+	}
+	for _, tsr := range tsrs {
+		ref := tsr.Clone()
+		for i, v := range vals {
+			if math.IsNaN(v) && tsr.DataType() == INT {
+				v = 0
+			}
+			tsr.SetFloat1D(i, v)
+			ref.SetFloat1D(i, v)
+		}
+		tsr.SetNull1D(7, true)
+		ref.SetNull1D(7, true)
+		Clip(tsr, -1, 1.5)
+		hi := 1.5
+		if tsr.DataType() == INT {
+			hi = 1 // bounds are rounded inward for int types
+		}
+		clipTensor(ref, -1, hi, true, true)
+		for i := range vals {
+			tv, rv := tsr.FloatValue1D(i), ref.FloatValue1D(i)
+			if tv != rv && !(math.IsNaN(tv) && math.IsNaN(rv)) {
+				t.Errorf("Clip %v %d: %v != interface %v", tsr.DataType(), i, tv, rv)
+			}
+		}
+		if tsr.FloatValue1D(7) != -9 {
+			t.Errorf("Clip %v: Null value changed: %v", tsr.DataType(), tsr.FloatValue1D(7))
+		}
+	}
+	it := NewInt([]int{2}, nil, nil)
+	it.Values = []int{-5, 5}
+	Clip(it, math.Inf(-1), 3)
+	if it.Values[0] != -5 || it.Values[1] != 3 {
+		t.Errorf("Clip Int infinite bound: %v", it.Values)
+	}
+}
+
+func BenchmarkClipFloat32(b *testing.B) {
+	tsr := NewFloat32([]int{1000, 100}, nil, nil)
+	for i := range tsr.Values {
+		tsr.Values[i] = float32(i%200) - 100
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Clip(tsr, -50, 50)
+	}
+}
+
+func BenchmarkClipFloat32Interface(b *testing.B) {
+	tsr := NewFloat32([]int{1000, 100}, nil, nil)
+	for i := range tsr.Values {
+		tsr.Values[i] = float32(i%200) - 100
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		clipTensor(tsr, -50, 50, true, true)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"fmt"
+
+	"cogentcore.org/core/colors/colormap"
+)
+
+// SetColorMap sets the "colormap" meta data on given tensor, used by
+// etview.TensorGrid to display the tensor values, to given colormap name.
+// Returns an error, without setting anything, if name is not one of the
+// registered colormap.AvailableMaps, so that an unknown name does not
+// silently fall back to the default colormap.
+func SetColorMap(tsr Tensor, name string) error {
+	if _, ok := colormap.AvailableMaps[name]; !ok {
+		return fmt.Errorf("etensor.SetColorMap: colormap %q not found in colormap.AvailableMaps", name)
+	}
+	tsr.SetMetaData("colormap", name)
+	return nil
+}
+
+// ColorMap returns the "colormap" meta data for given tensor,
+// or "" if not set.
+func ColorMap(tsr Tensor) string {
+	cm, _ := tsr.MetaData("colormap")
+	return cm
+}
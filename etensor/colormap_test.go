@@ -0,0 +1,26 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import "testing"
+
+func TestSetColorMap(t *testing.T) {
+	tsr := NewFloat64([]int{2, 2}, nil, nil)
+	if tsr.ColorMap() != "" {
+		t.Errorf("ColorMap: %q should be empty", tsr.ColorMap())
+	}
+	if err := tsr.SetColorMap("ColdHot"); err != nil {
+		t.Error(err)
+	}
+	if tsr.ColorMap() != "ColdHot" {
+		t.Errorf("ColorMap: %q != ColdHot", tsr.ColorMap())
+	}
+	if err := tsr.SetColorMap("ColdHott"); err == nil {
+		t.Error("SetColorMap: expected error for unknown colormap")
+	}
+	if tsr.ColorMap() != "ColdHot" {
+		t.Errorf("ColorMap: %q changed by invalid SetColorMap", tsr.ColorMap())
+	}
+}
@@ -34,6 +34,10 @@ type Tensor interface {
 	// Len returns the number of elements in the tensor (product of shape dimensions).
 	Len() int
 
+	// SizeBytes returns the approximate size in bytes of the backing storage
+	// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+	SizeBytes() int
+
 	// DataType returns the type of data, using arrow.DataType (ID() is the arrow.Type enum value)
 	DataType() Type
 
@@ -136,6 +140,14 @@ type Tensor interface {
 	// SetFloat1D sets the value of given 1-dimensional index (0-Len()-1) as a float64
 	SetFloat1D(i int, val float64)
 
+	// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+	// Try version returns an error if the index is out of range, instead of panicking.
+	FloatValue1DTry(i int) (float64, error)
+
+	// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+	// Try version returns an error if the index is out of range, instead of panicking.
+	SetFloat1DTry(i int, val float64) error
+
 	// FloatValueRowCell returns the value at given row and cell, where row is outer-most dim,
 	// and cell is 1D index into remaining inner dims -- for etable.Table columns
 	FloatValueRowCell(row, cell int) float64
@@ -193,6 +205,11 @@ type Tensor interface {
 	// Other math operations can be done using gonum/floats package.
 	Range() (min, max float64, minIndex, maxIndex int)
 
+	// RowRange returns the min, max of the values within the cell at given row,
+	// i.e., over the inner dimensions, skipping NaN values, e.g., for per-row
+	// normalized display.  Returns 0, 0 if there are no values.
+	RowRange(row int) (min, max float64)
+
 	// Agg applies given aggregation function to each element in the tensor
 	// (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 	// init is the initial value for the agg variable. returns final aggregate value
@@ -216,6 +233,13 @@ type Tensor interface {
 	// that as a Tensor (which can be converted into the known type as needed).
 	Clone() Tensor
 
+	// Snapshot returns a copy of the tensor, as in Clone, that is always
+	// internally consistent, with values of the length of its shape, even if
+	// the tensor is being resized at the same time, and a copy of the meta data.
+	// This is for safe display of data that is being updated in another goroutine,
+	// in conjunction with a mutex, as in etable.Table.SnapshotLocked.
+	Snapshot() Tensor
+
 	// CopyFrom copies all avail values from other tensor into this tensor, with an
 	// optimized implementation if the other tensor is of the same type, and
 	// otherwise it goes through appropriate standard type.
@@ -237,6 +261,11 @@ type Tensor interface {
 	// existing names will be preserved if nil
 	SetShape(shape, strides []int, names []string)
 
+	// SetShapeSafe sets the shape parameters of the tensor as in SetShape, but
+	// only if the new shape has the same total length as the current one,
+	// and otherwise returns an error, so that no values are ever discarded.
+	SetShapeSafe(shape, strides []int, names []string) error
+
 	// SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 	// Does nothing for other stride layouts
 	SetNumRows(rows int)
@@ -0,0 +1,125 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"fmt"
+	"log"
+)
+
+// Flatten returns a new 1D tensor of the same type as given tensor,
+// with a copy of all of its values in row-major order,
+// e.g., a [2,3] tensor becomes a [6] tensor.
+// See FlatView for a version that shares memory with the original.
+func Flatten(tsr Tensor) Tensor {
+	ln := tsr.Len()
+	ft := New(tsr.DataType(), []int{ln}, nil, nil)
+	if tsr.IsRowMajor() {
+		ft.CopyCellsFrom(tsr, 0, 0, ln)
+		return ft
+	}
+	isstr := tsr.DataType() == STRING
+	rsh := NewShape(tsr.Shapes(), nil, nil) // row-major order for index
+	for i := 0; i < ln; i++ {
+		idx := rsh.Index(i)
+		if isstr {
+			ft.SetString1D(i, tsr.StringValue(idx))
+		} else {
+			ft.SetFloat1D(i, tsr.FloatValue(idx))
+		}
+		if tsr.IsNull(idx) {
+			ft.SetNull1D(i, true)
+		}
+	}
+	return ft
+}
+
+// FlatView returns a 1D view onto the values of given tensor, with shape
+// [Len], sharing the same underlying memory (i.e., modifications will
+// affect both), including null values, but not meta data.
+// The tensor must be RowMajor, so that values are in row-major order
+// as in Flatten.
+// Returns nil and logs an error otherwise -- see Try version.
+// Use Flatten for a separate copy.
+func FlatView(tsr Tensor) Tensor {
+	fv, err := FlatViewTry(tsr)
+	if err != nil {
+		log.Println(err)
+	}
+	return fv
+}
+
+// FlatViewTry returns a 1D view onto the values of given tensor, with shape
+// [Len], sharing the same underlying memory (i.e., modifications will
+// affect both), including null values, but not meta data.
+// The tensor must be RowMajor, so that values are in row-major order
+// as in Flatten.
+// Returns an error otherwise.
+// Use Flatten for a separate copy.
+func FlatViewTry(tsr Tensor) (Tensor, error) {
+	if !tsr.IsRowMajor() {
+		return nil, fmt.Errorf("etensor.FlatView: tensor with shape %v and strides %v is not RowMajor", tsr.Shapes(), tsr.Strides())
+	}
+	var fv Tensor
+	switch t := tsr.(type) {
+	case *Float32:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Float64:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Int:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Int64:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Uint64:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Int32:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Uint32:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Int16:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Uint16:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Int8:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Uint8:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *String:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	case *Bits:
+		c := *t
+		c.Meta = nil
+		fv = &c
+	default:
+		return nil, fmt.Errorf("etensor.FlatView: tensor type %T not supported", tsr)
+	}
+	// note: Shape.SetShape allocates new slices, so the original shape is unaffected
+	fv.SetShape([]int{tsr.Len()}, nil, nil)
+	return fv, nil
+}
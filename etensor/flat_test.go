@@ -0,0 +1,44 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	ts := NewFloat32([]int{2, 3}, nil, nil)
+	for i := 0; i < 6; i++ {
+		ts.SetFloat1D(i, float64(i+1))
+	}
+	ft := Flatten(ts)
+	if ft.DataType() != FLOAT32 || !slices.Equal(ft.Shapes(), []int{6}) {
+		t.Fatalf("Flatten: type %v shape %v != FLOAT32 [6]", ft.DataType(), ft.Shapes())
+	}
+	for i := 0; i < 6; i++ {
+		if ft.FloatValue1D(i) != float64(i+1) {
+			t.Errorf("Flatten value %d: %v != %v", i, ft.FloatValue1D(i), i+1)
+		}
+	}
+	ft.SetFloat1D(0, 100)
+	if ts.FloatValue1D(0) != 1 {
+		t.Error("Flatten should copy values")
+	}
+
+	fv := FlatView(ts)
+	if !slices.Equal(fv.Shapes(), []int{6}) || !slices.Equal(ts.Shapes(), []int{2, 3}) {
+		t.Fatalf("FlatView shape %v, original %v", fv.Shapes(), ts.Shapes())
+	}
+	fv.SetFloat1D(4, 50)
+	if ts.FloatValue([]int{1, 1}) != 50 {
+		t.Error("FlatView should share values")
+	}
+
+	cm := NewFloat32([]int{3, 2}, ColMajorStrides([]int{3, 2}), nil)
+	if _, err := FlatViewTry(cm); err == nil {
+		t.Error("FlatViewTry: expected error for ColMajor tensor")
+	}
+}
@@ -39,6 +39,23 @@ func NewFloat64(shape, strides []int, names []string) *Float64 {
 	return tsr
 }
 
+// NewFloat64FromSlice returns a new n-dimensional array of float64s with
+// a copy of the given values, in the given shape, which must have the same
+// number of elements as vals.  If shape is nil, a 1D tensor with the length
+// of vals is returned.  The inverse of [Float64.Floats].
+func NewFloat64FromSlice(vals []float64, shape []int) *Float64 {
+	if shape == nil {
+		shape = []int{len(vals)}
+	}
+	tsr := NewFloat64(shape, nil, nil)
+	if len(vals) != tsr.Len() {
+		log.Printf("etensor.NewFloat64FromSlice: length of provided vals: %d not proper length: %d", len(vals), tsr.Len())
+		return tsr
+	}
+	copy(tsr.Values, vals)
+	return tsr
+}
+
 // NewFloat64Shape returns a new n-dimensional array of float64s.
 // Using shape structure instead of separate slices, and optionally
 // existing values if vals != nil (must be of proper length) -- we
@@ -134,6 +151,25 @@ func (tsr *Float64) SetString(i []int, val string) {
 func (tsr *Float64) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Float64) SetFloat1D(off int, val float64) { tsr.Values[off] = float64(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Float64) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return tsr.Values[off], nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Float64) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = val
+	return nil
+}
+
 func (tsr *Float64) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -198,6 +234,31 @@ func (tsr *Float64) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Float64) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -259,6 +320,24 @@ func (tsr *Float64) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Float64) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewFloat64Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -312,6 +391,64 @@ func (tsr *Float64) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Float64) Gather(rows []int) *Float64 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewFloat64(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Float64) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
+// AddRowWise adds the values of the given bias tensor to every outer-most
+// row of this tensor, e.g., to add a constant pattern to each row of an
+// activation tensor column.  The bias shape must be the same as the cell
+// shape of this tensor (i.e., without the outer row dimension), optionally
+// with an outer dimension of 1.  Null values are not changed.
+// Only valid for RowMajor organization.
+func (tsr *Float64) AddRowWise(bias Tensor) error {
+	cshp := tsr.Shp[1:]
+	bshp := bias.Shapes()
+	if len(bshp) == len(tsr.Shp) && bshp[0] == 1 {
+		bshp = bshp[1:]
+	}
+	if !EqualInts(cshp, bshp) {
+		return fmt.Errorf("etensor.Float64.AddRowWise: bias shape: %v != cell shape: %v", bias.Shapes(), cshp)
+	}
+	rows, csz := tsr.RowCellSize()
+	for r := 0; r < rows; r++ {
+		for j := 0; j < csz; j++ {
+			i := r*csz + j
+			if tsr.Nulls != nil && tsr.Nulls.Index(i) {
+				continue
+			}
+			tsr.Values[i] += bias.FloatValue1D(j)
+		}
+	}
+	return nil
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Float64) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -328,6 +465,18 @@ func (tsr *Float64) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Float64) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Float64) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -409,6 +558,12 @@ func (tsr *Float64) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Float64) SizeBytes() int {
+	return len(tsr.Values)*8 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Float64) Label() string {
 	return fmt.Sprintf("Float64: %s", tsr.Shape.String())
@@ -441,11 +596,7 @@ func (tsr *Float64) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Float64) ToArrow() *tensor.Float64 {
 	bld := array.NewFloat64Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewFloat64Array()
 	return tensor.NewFloat64(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -468,12 +619,7 @@ func (tsr *Float64) FromArrow(arw *tensor.Float64, cpy bool) {
 	} else {
 		tsr.Values = arw.Float64Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -541,7 +687,7 @@ func (tsr *Float64) SymmetricDim() int {
 
 // SetMetaData sets a key=value meta data (stored as a map[string]string).
 // For TensorGrid display: top-zero=+/-, odd-row=+/-, image=+/-,
-// min, max set fixed min / max values, background=color
+// min, max set fixed min / max values, background=color, colormap=name
 func (tsr *Float64) SetMetaData(key, val string) {
 	if tsr.Meta == nil {
 		tsr.Meta = make(map[string]string)
@@ -558,6 +704,18 @@ func (tsr *Float64) MetaData(key string) (string, bool) {
 	return val, ok
 }
 
+// SetColorMap sets the colormap meta data used by TensorGrid display,
+// returning an error for an unknown colormap name -- see etensor.SetColorMap.
+func (tsr *Float64) SetColorMap(name string) error {
+	return SetColorMap(tsr, name)
+}
+
+// ColorMap returns the colormap meta data used by TensorGrid display,
+// or "" if not set.
+func (tsr *Float64) ColorMap() string {
+	return ColorMap(tsr)
+}
+
 // MetaDataMap returns the underlying map used for meta data
 func (tsr *Float64) MetaDataMap() map[string]string {
 	return tsr.Meta
@@ -0,0 +1,47 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGather(t *testing.T) {
+	ft := NewFloat64([]int{4, 2}, nil, []string{"row", "cell"})
+	for i := range ft.Values {
+		ft.Values[i] = float64(i)
+	}
+	ft.SetNull1D(5, true) // row 2, cell 1
+	ft.SetMetaData("colormap", "ColdHot")
+	gt := ft.Gather([]int{2, 0, 2})
+	if !slices.Equal(gt.Shapes(), []int{3, 2}) || gt.DimName(1) != "cell" {
+		t.Fatalf("Gather shape: %v names %v", gt.Shapes(), gt.DimNames())
+	}
+	if !slices.Equal(gt.Values, []float64{4, 5, 0, 1, 4, 5}) {
+		t.Errorf("Gather values: %v", gt.Values)
+	}
+	if !gt.IsNull1D(1) || gt.IsNull1D(3) || !gt.IsNull1D(5) {
+		t.Error("Gather: Null values not copied")
+	}
+	if cm, _ := gt.MetaData("colormap"); cm != "ColdHot" {
+		t.Error("Gather: meta data not copied")
+	}
+	gt.Values[0] = 100
+	if ft.Values[4] != 4 {
+		t.Error("Gather should copy values")
+	}
+
+	it := NewInt32([]int{3}, nil, nil)
+	it.Values = []int32{7, 8, 9}
+	if ig := it.Gather([]int{1}); !slices.Equal(ig.Values, []int32{8}) {
+		t.Errorf("Gather Int32: %v", ig.Values)
+	}
+	st := NewString([]int{3}, nil, nil)
+	st.Values = []string{"a", "b", "c"}
+	if sg := st.Gather([]int{2, 1}); !slices.Equal(sg.Values, []string{"c", "b"}) {
+		t.Errorf("Gather String: %v", sg.Values)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import "math"
+
+// Histogram returns the counts of the values in given tensor within each of
+// given number of equal-width bins over the [min, max] range, along with the
+// bin edges, which have bins+1 values: bin i covers [edges[i], edges[i+1]),
+// with the last bin also including max.  Null and NaN values are skipped,
+// and values outside of the range are dropped (see HistogramClamp to count
+// them in the end bins instead).  If min == max == 0, the range is
+// automatically set from the values.  String tensors, and bins < 1,
+// return nil.  See also the histogram package for table-based versions.
+func Histogram(tsr Tensor, bins int, min, max float64) (edges, counts []float64) {
+	return histogram(tsr, bins, min, max, false)
+}
+
+// HistogramClamp is Histogram where values outside of the [min, max] range
+// are clamped into the first and last bins instead of being dropped.
+func HistogramClamp(tsr Tensor, bins int, min, max float64) (edges, counts []float64) {
+	return histogram(tsr, bins, min, max, true)
+}
+
+// histogram does Histogram with optional clamping of out-of-range values
+func histogram(tsr Tensor, bins int, min, max float64, clamp bool) (edges, counts []float64) {
+	if bins < 1 || tsr.DataType() == STRING {
+		return nil, nil
+	}
+	ln := tsr.Len()
+	if min == 0 && max == 0 {
+		min = math.Inf(1)
+		max = math.Inf(-1)
+		for i := 0; i < ln; i++ {
+			if tsr.IsNull1D(i) {
+				continue
+			}
+			v := tsr.FloatValue1D(i)
+			if math.IsNaN(v) {
+				continue
+			}
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+		switch {
+		case math.IsInf(min, 1): // no values
+			min, max = 0, 1
+		case min == max:
+			min -= .5
+			max += .5
+		}
+	}
+	inc := (max - min) / float64(bins)
+	edges = make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = min + float64(i)*inc
+	}
+	edges[bins] = max
+	counts = make([]float64, bins)
+	for i := 0; i < ln; i++ {
+		if tsr.IsNull1D(i) {
+			continue
+		}
+		v := tsr.FloatValue1D(i)
+		if math.IsNaN(v) {
+			continue
+		}
+		if v < min || v > max {
+			if !clamp {
+				continue
+			}
+			v = math.Min(math.Max(v, min), max)
+		}
+		bin := 0
+		if inc > 0 {
+			bin = int((v - min) / inc)
+		}
+		if bin >= bins {
+			bin = bins - 1
+		}
+		counts[bin]++
+	}
+	return
+}
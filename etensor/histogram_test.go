@@ -0,0 +1,48 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	tsr := NewFloat64([]int{8}, nil, nil)
+	copy(tsr.Values, []float64{0, .5, 1, 1.5, 2, 4, -1, math.NaN()})
+	tsr.SetNull1D(1, true)
+
+	edges, counts := Histogram(tsr, 4, 0, 2)
+	if !slices.Equal(edges, []float64{0, .5, 1, 1.5, 2}) {
+		t.Errorf("Histogram edges: %v", edges)
+	}
+	if !slices.Equal(counts, []float64{1, 0, 1, 2}) {
+		t.Errorf("Histogram counts: %v", counts)
+	}
+
+	_, counts = HistogramClamp(tsr, 4, 0, 2)
+	if !slices.Equal(counts, []float64{2, 0, 1, 3}) {
+		t.Errorf("HistogramClamp counts: %v", counts)
+	}
+
+	edges, counts = Histogram(tsr, 5, 0, 0)
+	if edges[0] != -1 || edges[5] != 4 {
+		t.Errorf("Histogram auto range edges: %v", edges)
+	}
+	if !slices.Equal(counts, []float64{1, 1, 2, 1, 1}) {
+		t.Errorf("Histogram auto range counts: %v", counts)
+	}
+
+	it := NewInt([]int{3}, nil, nil)
+	it.Values = []int{2, 2, 2}
+	edges, counts = Histogram(it, 1, 0, 0)
+	if !slices.Equal(edges, []float64{1.5, 2.5}) || counts[0] != 3 {
+		t.Errorf("Histogram constant values: %v %v", edges, counts)
+	}
+	if e, c := Histogram(NewString([]int{2}, nil, nil), 3, 0, 1); e != nil || c != nil {
+		t.Error("Histogram of String should be nil")
+	}
+}
@@ -136,6 +136,25 @@ func (tsr *Int) SetString(i []int, val string) {
 func (tsr *Int) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Int) SetFloat1D(off int, val float64) { tsr.Values[off] = int(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = int(val)
+	return nil
+}
+
 func (tsr *Int) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -205,6 +224,31 @@ func (tsr *Int) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Int) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -266,6 +310,24 @@ func (tsr *Int) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Int) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewIntShape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -319,6 +381,36 @@ func (tsr *Int) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Int) Gather(rows []int) *Int {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewInt(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Int) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Int) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -335,6 +427,18 @@ func (tsr *Int) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Int) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Int) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -416,6 +520,12 @@ func (tsr *Int) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Int) SizeBytes() int {
+	return len(tsr.Values)*int(unsafe.Sizeof(int(0))) + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Int) Label() string {
 	return fmt.Sprintf("Int: %s", tsr.Shape.String())
@@ -452,11 +562,7 @@ func (tsr *Int) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Int) ToArrow() *tensor.Int64 {
 	bld := array.NewInt64Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(*(*[]int64)(unsafe.Pointer(&tsr.Values)), tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(*(*[]int64)(unsafe.Pointer(&tsr.Values)), nil)
-	}
+	bld.AppendValues(*(*[]int64)(unsafe.Pointer(&tsr.Values)), ArrowValids(tsr.Nulls))
 	vec := bld.NewInt64Array()
 	return tensor.NewInt64(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -479,12 +585,7 @@ func (tsr *Int) FromArrow(arw *tensor.Int64, cpy bool) {
 	} else {
 		tsr.Values = *(*[]int)(unsafe.Pointer(&vls))
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
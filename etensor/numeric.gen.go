@@ -14,7 +14,6 @@ import (
 	"strconv"
 	"strings"
 
-	"cogentcore.org/core/reflectx"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/apache/arrow/go/arrow/tensor"
@@ -122,10 +121,7 @@ func (tsr *Int64) SetNull1D(i int, nul bool) {
 func (tsr *Int64) FloatValue(i []int) float64    { j := tsr.Offset(i); return float64(tsr.Values[j]) }
 func (tsr *Int64) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Values[j] = int64(val) }
 
-func (tsr *Int64) StringValue(i []int) string {
-	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
-}
+func (tsr *Int64) StringValue(i []int) string { j := tsr.Offset(i); return int64String(tsr.Values[j]) }
 func (tsr *Int64) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		j := tsr.Offset(i)
@@ -136,6 +132,25 @@ func (tsr *Int64) SetString(i []int, val string) {
 func (tsr *Int64) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Int64) SetFloat1D(off int, val float64) { tsr.Values[off] = int64(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int64) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int64) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = int64(val)
+	return nil
+}
+
 func (tsr *Int64) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -164,7 +179,7 @@ func (tsr *Int64) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Int64) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Int64) StringValue1D(off int) string { return int64String(tsr.Values[off]) }
 func (tsr *Int64) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = int64(fv)
@@ -173,7 +188,7 @@ func (tsr *Int64) SetString1D(off int, val string) {
 
 func (tsr *Int64) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return int64String(tsr.Values[row*sz+cell])
 }
 func (tsr *Int64) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -182,6 +197,12 @@ func (tsr *Int64) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// int64String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func int64String(v int64) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -205,6 +226,31 @@ func (tsr *Int64) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Int64) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -266,6 +312,24 @@ func (tsr *Int64) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Int64) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewInt64Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -319,6 +383,36 @@ func (tsr *Int64) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Int64) Gather(rows []int) *Int64 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewInt64(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Int64) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Int64) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -335,6 +429,18 @@ func (tsr *Int64) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Int64) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Int64) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -416,6 +522,12 @@ func (tsr *Int64) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Int64) SizeBytes() int {
+	return len(tsr.Values)*8 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Int64) Label() string {
 	return fmt.Sprintf("Int64: %s", tsr.Shape.String())
@@ -448,11 +560,7 @@ func (tsr *Int64) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Int64) ToArrow() *tensor.Int64 {
 	bld := array.NewInt64Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewInt64Array()
 	return tensor.NewInt64(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -475,12 +583,7 @@ func (tsr *Int64) FromArrow(arw *tensor.Int64, cpy bool) {
 	} else {
 		tsr.Values = arw.Int64Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -687,7 +790,7 @@ func (tsr *Uint64) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Valu
 
 func (tsr *Uint64) StringValue(i []int) string {
 	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
+	return uint64String(tsr.Values[j])
 }
 func (tsr *Uint64) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -699,6 +802,25 @@ func (tsr *Uint64) SetString(i []int, val string) {
 func (tsr *Uint64) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Uint64) SetFloat1D(off int, val float64) { tsr.Values[off] = uint64(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint64) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint64) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = uint64(val)
+	return nil
+}
+
 func (tsr *Uint64) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -727,7 +849,7 @@ func (tsr *Uint64) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Uint64) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Uint64) StringValue1D(off int) string { return uint64String(tsr.Values[off]) }
 func (tsr *Uint64) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = uint64(fv)
@@ -736,7 +858,7 @@ func (tsr *Uint64) SetString1D(off int, val string) {
 
 func (tsr *Uint64) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return uint64String(tsr.Values[row*sz+cell])
 }
 func (tsr *Uint64) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -745,6 +867,12 @@ func (tsr *Uint64) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// uint64String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func uint64String(v uint64) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -768,6 +896,31 @@ func (tsr *Uint64) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Uint64) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -829,6 +982,24 @@ func (tsr *Uint64) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Uint64) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewUint64Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -882,6 +1053,36 @@ func (tsr *Uint64) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Uint64) Gather(rows []int) *Uint64 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewUint64(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Uint64) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Uint64) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -898,6 +1099,18 @@ func (tsr *Uint64) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Uint64) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Uint64) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -979,6 +1192,12 @@ func (tsr *Uint64) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Uint64) SizeBytes() int {
+	return len(tsr.Values)*8 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Uint64) Label() string {
 	return fmt.Sprintf("Uint64: %s", tsr.Shape.String())
@@ -1011,11 +1230,7 @@ func (tsr *Uint64) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Uint64) ToArrow() *tensor.Uint64 {
 	bld := array.NewUint64Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewUint64Array()
 	return tensor.NewUint64(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -1038,12 +1253,7 @@ func (tsr *Uint64) FromArrow(arw *tensor.Uint64, cpy bool) {
 	} else {
 		tsr.Values = arw.Uint64Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -1248,10 +1458,7 @@ func (tsr *Int32) SetNull1D(i int, nul bool) {
 func (tsr *Int32) FloatValue(i []int) float64    { j := tsr.Offset(i); return float64(tsr.Values[j]) }
 func (tsr *Int32) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Values[j] = int32(val) }
 
-func (tsr *Int32) StringValue(i []int) string {
-	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
-}
+func (tsr *Int32) StringValue(i []int) string { j := tsr.Offset(i); return int32String(tsr.Values[j]) }
 func (tsr *Int32) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		j := tsr.Offset(i)
@@ -1262,6 +1469,25 @@ func (tsr *Int32) SetString(i []int, val string) {
 func (tsr *Int32) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Int32) SetFloat1D(off int, val float64) { tsr.Values[off] = int32(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int32) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int32) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = int32(val)
+	return nil
+}
+
 func (tsr *Int32) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -1290,7 +1516,7 @@ func (tsr *Int32) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Int32) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Int32) StringValue1D(off int) string { return int32String(tsr.Values[off]) }
 func (tsr *Int32) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = int32(fv)
@@ -1299,7 +1525,7 @@ func (tsr *Int32) SetString1D(off int, val string) {
 
 func (tsr *Int32) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return int32String(tsr.Values[row*sz+cell])
 }
 func (tsr *Int32) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -1308,6 +1534,12 @@ func (tsr *Int32) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// int32String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func int32String(v int32) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -1331,6 +1563,31 @@ func (tsr *Int32) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Int32) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -1392,6 +1649,24 @@ func (tsr *Int32) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Int32) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewInt32Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -1445,6 +1720,36 @@ func (tsr *Int32) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Int32) Gather(rows []int) *Int32 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewInt32(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Int32) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Int32) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -1461,6 +1766,18 @@ func (tsr *Int32) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Int32) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Int32) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -1542,6 +1859,12 @@ func (tsr *Int32) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Int32) SizeBytes() int {
+	return len(tsr.Values)*4 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Int32) Label() string {
 	return fmt.Sprintf("Int32: %s", tsr.Shape.String())
@@ -1574,11 +1897,7 @@ func (tsr *Int32) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Int32) ToArrow() *tensor.Int32 {
 	bld := array.NewInt32Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewInt32Array()
 	return tensor.NewInt32(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -1601,12 +1920,7 @@ func (tsr *Int32) FromArrow(arw *tensor.Int32, cpy bool) {
 	} else {
 		tsr.Values = arw.Int32Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -1813,7 +2127,7 @@ func (tsr *Uint32) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Valu
 
 func (tsr *Uint32) StringValue(i []int) string {
 	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
+	return uint32String(tsr.Values[j])
 }
 func (tsr *Uint32) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -1825,6 +2139,25 @@ func (tsr *Uint32) SetString(i []int, val string) {
 func (tsr *Uint32) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Uint32) SetFloat1D(off int, val float64) { tsr.Values[off] = uint32(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint32) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint32) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = uint32(val)
+	return nil
+}
+
 func (tsr *Uint32) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -1853,7 +2186,7 @@ func (tsr *Uint32) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Uint32) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Uint32) StringValue1D(off int) string { return uint32String(tsr.Values[off]) }
 func (tsr *Uint32) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = uint32(fv)
@@ -1862,7 +2195,7 @@ func (tsr *Uint32) SetString1D(off int, val string) {
 
 func (tsr *Uint32) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return uint32String(tsr.Values[row*sz+cell])
 }
 func (tsr *Uint32) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -1871,6 +2204,12 @@ func (tsr *Uint32) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// uint32String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func uint32String(v uint32) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -1894,6 +2233,31 @@ func (tsr *Uint32) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Uint32) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -1955,6 +2319,24 @@ func (tsr *Uint32) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Uint32) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewUint32Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -2008,6 +2390,36 @@ func (tsr *Uint32) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Uint32) Gather(rows []int) *Uint32 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewUint32(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Uint32) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Uint32) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -2024,6 +2436,18 @@ func (tsr *Uint32) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Uint32) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Uint32) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -2105,6 +2529,12 @@ func (tsr *Uint32) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Uint32) SizeBytes() int {
+	return len(tsr.Values)*4 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Uint32) Label() string {
 	return fmt.Sprintf("Uint32: %s", tsr.Shape.String())
@@ -2137,11 +2567,7 @@ func (tsr *Uint32) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Uint32) ToArrow() *tensor.Uint32 {
 	bld := array.NewUint32Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewUint32Array()
 	return tensor.NewUint32(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -2164,12 +2590,7 @@ func (tsr *Uint32) FromArrow(arw *tensor.Uint32, cpy bool) {
 	} else {
 		tsr.Values = arw.Uint32Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -2376,7 +2797,7 @@ func (tsr *Float32) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Val
 
 func (tsr *Float32) StringValue(i []int) string {
 	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
+	return float32String(tsr.Values[j])
 }
 func (tsr *Float32) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -2388,6 +2809,25 @@ func (tsr *Float32) SetString(i []int, val string) {
 func (tsr *Float32) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Float32) SetFloat1D(off int, val float64) { tsr.Values[off] = float32(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Float32) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Float32) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = float32(val)
+	return nil
+}
+
 func (tsr *Float32) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -2416,7 +2856,7 @@ func (tsr *Float32) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Float32) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Float32) StringValue1D(off int) string { return float32String(tsr.Values[off]) }
 func (tsr *Float32) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = float32(fv)
@@ -2425,7 +2865,7 @@ func (tsr *Float32) SetString1D(off int, val string) {
 
 func (tsr *Float32) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return float32String(tsr.Values[row*sz+cell])
 }
 func (tsr *Float32) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -2434,6 +2874,12 @@ func (tsr *Float32) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// float32String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func float32String(v float32) string {
+	return strconv.FormatFloat(float64(v), 'G', -1, 32)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -2457,6 +2903,31 @@ func (tsr *Float32) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Float32) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -2518,6 +2989,24 @@ func (tsr *Float32) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Float32) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewFloat32Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -2571,6 +3060,36 @@ func (tsr *Float32) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Float32) Gather(rows []int) *Float32 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewFloat32(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Float32) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Float32) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -2587,6 +3106,18 @@ func (tsr *Float32) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Float32) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Float32) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -2668,6 +3199,12 @@ func (tsr *Float32) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Float32) SizeBytes() int {
+	return len(tsr.Values)*4 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Float32) Label() string {
 	return fmt.Sprintf("Float32: %s", tsr.Shape.String())
@@ -2700,11 +3237,7 @@ func (tsr *Float32) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Float32) ToArrow() *tensor.Float32 {
 	bld := array.NewFloat32Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewFloat32Array()
 	return tensor.NewFloat32(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -2727,12 +3260,7 @@ func (tsr *Float32) FromArrow(arw *tensor.Float32, cpy bool) {
 	} else {
 		tsr.Values = arw.Float32Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -2937,10 +3465,7 @@ func (tsr *Int16) SetNull1D(i int, nul bool) {
 func (tsr *Int16) FloatValue(i []int) float64    { j := tsr.Offset(i); return float64(tsr.Values[j]) }
 func (tsr *Int16) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Values[j] = int16(val) }
 
-func (tsr *Int16) StringValue(i []int) string {
-	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
-}
+func (tsr *Int16) StringValue(i []int) string { j := tsr.Offset(i); return int16String(tsr.Values[j]) }
 func (tsr *Int16) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		j := tsr.Offset(i)
@@ -2951,6 +3476,25 @@ func (tsr *Int16) SetString(i []int, val string) {
 func (tsr *Int16) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Int16) SetFloat1D(off int, val float64) { tsr.Values[off] = int16(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int16) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int16) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = int16(val)
+	return nil
+}
+
 func (tsr *Int16) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -2979,7 +3523,7 @@ func (tsr *Int16) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Int16) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Int16) StringValue1D(off int) string { return int16String(tsr.Values[off]) }
 func (tsr *Int16) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = int16(fv)
@@ -2988,7 +3532,7 @@ func (tsr *Int16) SetString1D(off int, val string) {
 
 func (tsr *Int16) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return int16String(tsr.Values[row*sz+cell])
 }
 func (tsr *Int16) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -2997,6 +3541,12 @@ func (tsr *Int16) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// int16String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func int16String(v int16) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -3020,6 +3570,31 @@ func (tsr *Int16) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Int16) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -3081,6 +3656,24 @@ func (tsr *Int16) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Int16) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewInt16Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -3134,6 +3727,36 @@ func (tsr *Int16) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Int16) Gather(rows []int) *Int16 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewInt16(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Int16) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Int16) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -3150,6 +3773,18 @@ func (tsr *Int16) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Int16) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Int16) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -3231,6 +3866,12 @@ func (tsr *Int16) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Int16) SizeBytes() int {
+	return len(tsr.Values)*2 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Int16) Label() string {
 	return fmt.Sprintf("Int16: %s", tsr.Shape.String())
@@ -3263,11 +3904,7 @@ func (tsr *Int16) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Int16) ToArrow() *tensor.Int16 {
 	bld := array.NewInt16Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewInt16Array()
 	return tensor.NewInt16(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -3290,12 +3927,7 @@ func (tsr *Int16) FromArrow(arw *tensor.Int16, cpy bool) {
 	} else {
 		tsr.Values = arw.Int16Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -3502,7 +4134,7 @@ func (tsr *Uint16) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Valu
 
 func (tsr *Uint16) StringValue(i []int) string {
 	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
+	return uint16String(tsr.Values[j])
 }
 func (tsr *Uint16) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -3514,6 +4146,25 @@ func (tsr *Uint16) SetString(i []int, val string) {
 func (tsr *Uint16) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Uint16) SetFloat1D(off int, val float64) { tsr.Values[off] = uint16(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint16) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint16) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = uint16(val)
+	return nil
+}
+
 func (tsr *Uint16) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -3542,7 +4193,7 @@ func (tsr *Uint16) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Uint16) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Uint16) StringValue1D(off int) string { return uint16String(tsr.Values[off]) }
 func (tsr *Uint16) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = uint16(fv)
@@ -3551,7 +4202,7 @@ func (tsr *Uint16) SetString1D(off int, val string) {
 
 func (tsr *Uint16) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return uint16String(tsr.Values[row*sz+cell])
 }
 func (tsr *Uint16) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -3560,6 +4211,12 @@ func (tsr *Uint16) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// uint16String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func uint16String(v uint16) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -3583,6 +4240,31 @@ func (tsr *Uint16) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Uint16) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -3644,6 +4326,24 @@ func (tsr *Uint16) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Uint16) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewUint16Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -3697,6 +4397,36 @@ func (tsr *Uint16) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Uint16) Gather(rows []int) *Uint16 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewUint16(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Uint16) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Uint16) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -3713,6 +4443,18 @@ func (tsr *Uint16) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Uint16) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Uint16) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -3794,6 +4536,12 @@ func (tsr *Uint16) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Uint16) SizeBytes() int {
+	return len(tsr.Values)*2 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Uint16) Label() string {
 	return fmt.Sprintf("Uint16: %s", tsr.Shape.String())
@@ -3826,11 +4574,7 @@ func (tsr *Uint16) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Uint16) ToArrow() *tensor.Uint16 {
 	bld := array.NewUint16Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewUint16Array()
 	return tensor.NewUint16(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -3853,12 +4597,7 @@ func (tsr *Uint16) FromArrow(arw *tensor.Uint16, cpy bool) {
 	} else {
 		tsr.Values = arw.Uint16Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -4063,10 +4802,7 @@ func (tsr *Int8) SetNull1D(i int, nul bool) {
 func (tsr *Int8) FloatValue(i []int) float64    { j := tsr.Offset(i); return float64(tsr.Values[j]) }
 func (tsr *Int8) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Values[j] = int8(val) }
 
-func (tsr *Int8) StringValue(i []int) string {
-	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
-}
+func (tsr *Int8) StringValue(i []int) string { j := tsr.Offset(i); return int8String(tsr.Values[j]) }
 func (tsr *Int8) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		j := tsr.Offset(i)
@@ -4077,6 +4813,25 @@ func (tsr *Int8) SetString(i []int, val string) {
 func (tsr *Int8) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Int8) SetFloat1D(off int, val float64) { tsr.Values[off] = int8(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int8) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Int8) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = int8(val)
+	return nil
+}
+
 func (tsr *Int8) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -4105,7 +4860,7 @@ func (tsr *Int8) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Int8) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Int8) StringValue1D(off int) string { return int8String(tsr.Values[off]) }
 func (tsr *Int8) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = int8(fv)
@@ -4114,7 +4869,7 @@ func (tsr *Int8) SetString1D(off int, val string) {
 
 func (tsr *Int8) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return int8String(tsr.Values[row*sz+cell])
 }
 func (tsr *Int8) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -4123,6 +4878,12 @@ func (tsr *Int8) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// int8String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func int8String(v int8) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -4146,6 +4907,31 @@ func (tsr *Int8) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Int8) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -4207,6 +4993,24 @@ func (tsr *Int8) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Int8) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewInt8Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -4260,6 +5064,36 @@ func (tsr *Int8) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Int8) Gather(rows []int) *Int8 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewInt8(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Int8) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Int8) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -4276,6 +5110,18 @@ func (tsr *Int8) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Int8) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Int8) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -4357,6 +5203,12 @@ func (tsr *Int8) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Int8) SizeBytes() int {
+	return len(tsr.Values)*1 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Int8) Label() string {
 	return fmt.Sprintf("Int8: %s", tsr.Shape.String())
@@ -4389,11 +5241,7 @@ func (tsr *Int8) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Int8) ToArrow() *tensor.Int8 {
 	bld := array.NewInt8Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewInt8Array()
 	return tensor.NewInt8(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -4416,12 +5264,7 @@ func (tsr *Int8) FromArrow(arw *tensor.Int8, cpy bool) {
 	} else {
 		tsr.Values = arw.Int8Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -4626,10 +5469,7 @@ func (tsr *Uint8) SetNull1D(i int, nul bool) {
 func (tsr *Uint8) FloatValue(i []int) float64    { j := tsr.Offset(i); return float64(tsr.Values[j]) }
 func (tsr *Uint8) SetFloat(i []int, val float64) { j := tsr.Offset(i); tsr.Values[j] = uint8(val) }
 
-func (tsr *Uint8) StringValue(i []int) string {
-	j := tsr.Offset(i)
-	return reflectx.ToString(tsr.Values[j])
-}
+func (tsr *Uint8) StringValue(i []int) string { j := tsr.Offset(i); return uint8String(tsr.Values[j]) }
 func (tsr *Uint8) SetString(i []int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		j := tsr.Offset(i)
@@ -4640,6 +5480,25 @@ func (tsr *Uint8) SetString(i []int, val string) {
 func (tsr *Uint8) FloatValue1D(off int) float64    { return float64(tsr.Values[off]) }
 func (tsr *Uint8) SetFloat1D(off int, val float64) { tsr.Values[off] = uint8(val) }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint8) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return float64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *Uint8) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = uint8(val)
+	return nil
+}
+
 func (tsr *Uint8) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return float64(tsr.Values[row*sz+cell])
@@ -4668,7 +5527,7 @@ func (tsr *Uint8) SetFloats(vals []float64) {
 	}
 }
 
-func (tsr *Uint8) StringValue1D(off int) string { return reflectx.ToString(tsr.Values[off]) }
+func (tsr *Uint8) StringValue1D(off int) string { return uint8String(tsr.Values[off]) }
 func (tsr *Uint8) SetString1D(off int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
 		tsr.Values[off] = uint8(fv)
@@ -4677,7 +5536,7 @@ func (tsr *Uint8) SetString1D(off int, val string) {
 
 func (tsr *Uint8) StringValueRowCell(row, cell int) string {
 	_, sz := tsr.RowCellSize()
-	return reflectx.ToString(tsr.Values[row*sz+cell])
+	return uint8String(tsr.Values[row*sz+cell])
 }
 func (tsr *Uint8) SetStringRowCell(row, cell int, val string) {
 	if fv, err := strconv.ParseFloat(val, 64); err == nil {
@@ -4686,6 +5545,12 @@ func (tsr *Uint8) SetStringRowCell(row, cell int, val string) {
 	}
 }
 
+// uint8String returns the given value formatted as a number,
+// including for uint8, which reflectx.ToString formats as a character.
+func uint8String(v uint8) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
 // Range returns the min, max (and associated indexes, -1 = no values) for the tensor.
 // This is needed for display and is thus in the core api in optimized form
 // Other math operations can be done using gonum/floats package.
@@ -4709,6 +5574,31 @@ func (tsr *Uint8) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange returns the min, max of the values within the cell at given row,
+// i.e., over the inner dimensions using RowCellSize, skipping NaN values,
+// e.g., for per-row normalized display.  Returns 0, 0 if there are no values.
+func (tsr *Uint8) RowRange(row int) (min, max float64) {
+	rows, sz := tsr.RowCellSize()
+	if row < 0 || row >= rows {
+		return
+	}
+	has := false
+	for _, vl := range tsr.Values[row*sz : (row+1)*sz] {
+		fv := float64(vl)
+		if math.IsNaN(fv) {
+			continue
+		}
+		if fv < min || !has {
+			min = fv
+		}
+		if fv > max || !has {
+			max = fv
+		}
+		has = true
+	}
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -4770,6 +5660,24 @@ func (tsr *Uint8) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *Uint8) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewUint8Shape(&tsr.Shape, nil)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -4823,6 +5731,36 @@ func (tsr *Uint8) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *Uint8) Gather(rows []int) *Uint8 {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewUint8(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
+// Clip clamps all of the values into the [min, max] range, in place,
+// skipping Null and NaN values -- see the Clip function for details.
+func (tsr *Uint8) Clip(min, max float64) {
+	Clip(tsr, min, max)
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *Uint8) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -4839,6 +5777,18 @@ func (tsr *Uint8) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *Uint8) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *Uint8) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -4920,6 +5870,12 @@ func (tsr *Uint8) SubSpaceTry(offs []int) (Tensor, error) {
 	return nil, errors.New("SubSpace only valid for RowMajor or ColMajor tensors")
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values and any Nulls, for memory profiling.
+func (tsr *Uint8) SizeBytes() int {
+	return len(tsr.Values)*1 + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *Uint8) Label() string {
 	return fmt.Sprintf("Uint8: %s", tsr.Shape.String())
@@ -4952,11 +5908,7 @@ func (tsr *Uint8) String() string {
 // ToArrow returns the apache arrow equivalent of the tensor
 func (tsr *Uint8) ToArrow() *tensor.Uint8 {
 	bld := array.NewUint8Builder(memory.DefaultAllocator)
-	if tsr.Nulls != nil {
-		bld.AppendValues(tsr.Values, tsr.Nulls.ToBools())
-	} else {
-		bld.AppendValues(tsr.Values, nil)
-	}
+	bld.AppendValues(tsr.Values, ArrowValids(tsr.Nulls))
 	vec := bld.NewUint8Array()
 	return tensor.NewUint8(vec.Data(), tsr.Shape64(), tsr.Strides64(), tsr.DimNames())
 }
@@ -4979,12 +5931,7 @@ func (tsr *Uint8) FromArrow(arw *tensor.Uint8, cpy bool) {
 	} else {
 		tsr.Values = arw.Uint8Values()
 	}
-	// note: doesn't look like the Data() exposes the nulls themselves so it is not
-	// clear we can copy the null values -- nor does it seem that the tensor class
-	// exposes it either!  https://github.com/apache/arrow/issues/3496
-	// nln := arw.Data().NullN()
-	// if nln > 0 {
-	// }
+	tsr.Nulls = NullsFromArrow(arw.Data())
 }
 
 // Dims is the gonum/mat.Matrix interface method for returning the dimensionality of the
@@ -5090,7 +6037,8 @@ func (tsr *Uint8) CopyMetaData(frm Tensor) {
 }
 
 // New returns a new Tensor of given type, using our Type specifier which is
-// isomorphic with arrow.Type
+// isomorphic with arrow.Type.  Returns nil for types that have no tensor
+// implementation (NULL, FLOAT16) -- any new Type must be added here.
 func New(dtype Type, shape, strides []int, names []string) Tensor {
 	switch dtype {
 	case BOOL:
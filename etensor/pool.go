@@ -0,0 +1,115 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"slices"
+	"sync"
+)
+
+// Pooled tensors are allocated from a set of sync.Pool pools, one per
+// tensor length, for each of Float64 and Float32, to reduce allocation and
+// GC pressure in code that creates and discards many small tensors of the
+// same shape in tight loops.
+//
+// Ownership rules:
+//   - A tensor returned by GetPooled or GetPooledFloat32 is owned by the
+//     caller, exactly as if it had been created by NewFloat64 or NewFloat32:
+//     it has row-major strides, empty dimension names, zero values, and
+//     no Nulls or meta data.
+//   - PutPooled returns ownership to the pool: after calling it, the tensor
+//     must not be used in any way, and neither can any other tensor that
+//     shares its Values, e.g., a SubSpace or FlatView of it.
+//   - Never put a tensor that is still referenced elsewhere, e.g., a column
+//     of an etable.Table or a tensor displayed in a view.
+//   - A tensor does not need to come from the pool to be put into it,
+//     and pooled tensors that are never put back are simply collected
+//     by the GC as usual.
+var (
+	float64Pools sync.Map // map[int]*sync.Pool of *Float64, by Len
+	float32Pools sync.Map // map[int]*sync.Pool of *Float32, by Len
+)
+
+// tensorPool returns the pool for tensors of given length in given pools
+func tensorPool(pools *sync.Map, n int) *sync.Pool {
+	if p, ok := pools.Load(n); ok {
+		return p.(*sync.Pool)
+	}
+	p, _ := pools.LoadOrStore(n, &sync.Pool{})
+	return p.(*sync.Pool)
+}
+
+// pooledLen returns the number of elements for given shape
+func pooledLen(shape []int) int {
+	if len(shape) == 0 {
+		return 0
+	}
+	n := 1
+	for _, v := range shape {
+		n *= v
+	}
+	return n
+}
+
+// resetPooledShape sets given shape to the given row-major shape with
+// empty names, re-using the existing slices if the shape is the same.
+func resetPooledShape(sh *Shape, shape []int) {
+	if !slices.Equal(sh.Shp, shape) || !sh.IsRowMajor() || len(sh.Nms) != len(shape) {
+		sh.SetShape(shape, nil, nil)
+		return
+	}
+	clear(sh.Nms)
+}
+
+// GetPooled returns a Float64 tensor of given shape from the pool of
+// tensors with the same number of elements, or a new one if none is
+// available.  It is equivalent to NewFloat64(shape, nil, nil).
+// See the pool ownership rules above: call PutPooled when done with it.
+func GetPooled(shape []int) *Float64 {
+	n := pooledLen(shape)
+	if tsr, ok := tensorPool(&float64Pools, n).Get().(*Float64); ok {
+		resetPooledShape(&tsr.Shape, shape)
+		clear(tsr.Values)
+		return tsr
+	}
+	return NewFloat64(shape, nil, nil)
+}
+
+// GetPooledFloat32 returns a Float32 tensor of given shape from the pool of
+// tensors with the same number of elements, or a new one if none is
+// available.  It is equivalent to NewFloat32(shape, nil, nil).
+// See the pool ownership rules above: call PutPooled when done with it.
+func GetPooledFloat32(shape []int) *Float32 {
+	n := pooledLen(shape)
+	if tsr, ok := tensorPool(&float32Pools, n).Get().(*Float32); ok {
+		resetPooledShape(&tsr.Shape, shape)
+		clear(tsr.Values)
+		return tsr
+	}
+	return NewFloat32(shape, nil, nil)
+}
+
+// PutPooled returns given Float64 or Float32 tensor to the pool, for re-use
+// by GetPooled or GetPooledFloat32 -- the tensor must not be used after this
+// call (see the pool ownership rules above).  Tensors of other types, and
+// tensors whose Values do not match their shape, are ignored.
+func PutPooled(tsr Tensor) {
+	switch t := tsr.(type) {
+	case *Float64:
+		if t == nil || len(t.Values) != t.Len() {
+			return
+		}
+		t.Nulls = nil
+		t.Meta = nil
+		tensorPool(&float64Pools, len(t.Values)).Put(t)
+	case *Float32:
+		if t == nil || len(t.Values) != t.Len() {
+			return
+		}
+		t.Nulls = nil
+		t.Meta = nil
+		tensorPool(&float32Pools, len(t.Values)).Put(t)
+	}
+}
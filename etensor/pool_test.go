@@ -0,0 +1,64 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPooled(t *testing.T) {
+	tsr := GetPooled([]int{3, 4})
+	if !slices.Equal(tsr.Shapes(), []int{3, 4}) || len(tsr.Values) != 12 {
+		t.Fatalf("GetPooled: shape %v len %d", tsr.Shapes(), len(tsr.Values))
+	}
+	tsr.Nms[0] = "Row"
+	tsr.Values[5] = 1
+	tsr.SetNull1D(3, true)
+	tsr.SetMetaData("min", "0")
+	PutPooled(tsr)
+
+	// same length, different shape: must be fully reset either way
+	for _, shp := range [][]int{{3, 4}, {2, 6}} {
+		pt := GetPooled(shp)
+		if !slices.Equal(pt.Shapes(), shp) || !pt.IsRowMajor() {
+			t.Errorf("GetPooled: shape %v strides %v != %v", pt.Shapes(), pt.Strides(), shp)
+		}
+		if pt.DimName(0) != "" || pt.Nulls != nil || pt.Meta != nil {
+			t.Error("GetPooled: names, Nulls or meta data not reset")
+		}
+		if slices.Max(pt.Values) != 0 {
+			t.Errorf("GetPooled: values not zeroed: %v", pt.Values)
+		}
+		PutPooled(pt)
+	}
+
+	ft := GetPooledFloat32([]int{5})
+	ft.Values[0] = 2
+	PutPooled(ft)
+	if ft2 := GetPooledFloat32([]int{5}); ft2.Values[0] != 0 || ft2.Len() != 5 {
+		t.Errorf("GetPooledFloat32: %v", ft2.Values)
+	}
+	PutPooled(NewInt([]int{2}, nil, nil)) // ignored
+}
+
+func BenchmarkNewFloat64Shape(b *testing.B) {
+	shp := NewShape([]int{16, 16}, nil, nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tsr := NewFloat64Shape(shp, nil)
+		tsr.Values[i%256] = 1
+	}
+}
+
+func BenchmarkPooled(b *testing.B) {
+	shp := []int{16, 16}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tsr := GetPooled(shp)
+		tsr.Values[i%256] = 1
+		PutPooled(tsr)
+	}
+}
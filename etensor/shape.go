@@ -116,6 +116,16 @@ func (sh *Shape) Len() int {
 	return int(o)
 }
 
+// sameLenTry returns an error if the total length of given shape
+// differs from our current length, for SetShapeSafe.
+func (sh *Shape) sameLenTry(shape []int) error {
+	nsh := Shape{Shp: shape}
+	if nln, ln := nsh.Len(), sh.Len(); nln != ln {
+		return fmt.Errorf("etensor.SetShapeSafe: new shape: %v length: %d != current length: %d", shape, nln, ln)
+	}
+	return nil
+}
+
 // Shapes returns the slice of dimension sizes.
 // This is *not* a copy -- modifications will change the shape.
 func (sh *Shape) Shapes() []int { return sh.Shp }
@@ -187,6 +197,15 @@ func (sh *Shape) IndexIsValid(idx []int) bool {
 	return true
 }
 
+// Offset1DTry returns an error if given 1-dimensional offset is
+// not within the 0-Len()-1 range, for the Try versions of 1D accessors.
+func (sh *Shape) Offset1DTry(off int) error {
+	if n := sh.Len(); off < 0 || off >= n {
+		return fmt.Errorf("etensor: 1D offset: %d out of range for tensor of Len: %d", off, n)
+	}
+	return nil
+}
+
 // todo: cache rowmajor vs. colmajor as flags?  much faster, and this is frequently
 // accessed
 
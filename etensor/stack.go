@@ -0,0 +1,77 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"fmt"
+	"log"
+	"slices"
+)
+
+// Stack returns a new tensor that stacks the given tensors, which must all
+// have the same shape, along a new axis inserted at given position
+// (0 = outermost), having len(tsrs) elements -- the analog of numpy.stack.
+// For example, stacking four [3,3] tensors at axis 0 gives a [4,3,3] tensor.
+// The result has the data type of the first tensor, and assumes RowMajor layout.
+// Returns nil and logs an error if shapes are not equal -- see Try version.
+func Stack(tsrs []Tensor, axis int) Tensor {
+	st, err := StackTry(tsrs, axis)
+	if err != nil {
+		log.Println(err)
+	}
+	return st
+}
+
+// StackTry returns a new tensor that stacks the given tensors, which must all
+// have the same shape, along a new axis inserted at given position
+// (0 = outermost), having len(tsrs) elements -- the analog of numpy.stack.
+// For example, stacking four [3,3] tensors at axis 0 gives a [4,3,3] tensor.
+// The result has the data type of the first tensor, and assumes RowMajor layout.
+// Returns an error if there are no tensors, shapes are not equal,
+// or axis is out of range.
+func StackTry(tsrs []Tensor, axis int) (Tensor, error) {
+	n := len(tsrs)
+	if n == 0 {
+		return nil, fmt.Errorf("etensor.Stack: no tensors provided")
+	}
+	shp := tsrs[0].Shapes()
+	if axis < 0 || axis > len(shp) {
+		return nil, fmt.Errorf("etensor.Stack: axis %d out of range for tensors with %d dims", axis, len(shp))
+	}
+	for i, ts := range tsrs {
+		if !slices.Equal(ts.Shapes(), shp) {
+			return nil, fmt.Errorf("etensor.Stack: tensor %d shape %v != tensor 0 shape %v", i, ts.Shapes(), shp)
+		}
+	}
+	oshp := slices.Insert(slices.Clone(shp), axis, n)
+	var onms []string
+	if nms := tsrs[0].DimNames(); len(nms) == len(shp) {
+		onms = slices.Insert(slices.Clone(nms), axis, "")
+	}
+	st := New(tsrs[0].DataType(), oshp, nil, onms)
+	outer := 1
+	for _, d := range shp[:axis] {
+		outer *= d
+	}
+	inner := 1
+	for _, d := range shp[axis:] {
+		inner *= d
+	}
+	isstr := st.DataType() == STRING
+	for o := 0; o < outer; o++ {
+		for k, ts := range tsrs {
+			soff := o * inner
+			toff := (o*n + k) * inner
+			for i := 0; i < inner; i++ {
+				if isstr {
+					st.SetString1D(toff+i, ts.StringValue1D(soff+i))
+				} else {
+					st.SetFloat1D(toff+i, ts.FloatValue1D(soff+i))
+				}
+			}
+		}
+	}
+	return st, nil
+}
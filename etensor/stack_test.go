@@ -0,0 +1,51 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	tsrs := make([]Tensor, 4)
+	for k := range tsrs {
+		ts := NewFloat32([]int{3, 3}, nil, nil)
+		for i := 0; i < 9; i++ {
+			ts.SetFloat1D(i, float64(k*10+i))
+		}
+		tsrs[k] = ts
+	}
+	st, err := StackTry(tsrs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(st.Shapes(), []int{4, 3, 3}) {
+		t.Fatalf("Stack axis 0 shape: %v != [4 3 3]", st.Shapes())
+	}
+	for k := 0; k < 4; k++ {
+		for y := 0; y < 3; y++ {
+			for x := 0; x < 3; x++ {
+				ex := float64(k*10 + y*3 + x)
+				if v := st.FloatValue([]int{k, y, x}); v != ex {
+					t.Errorf("Stack axis 0 [%d,%d,%d]: %v != %v", k, y, x, v, ex)
+				}
+			}
+		}
+	}
+
+	st = Stack(tsrs, 2)
+	if !slices.Equal(st.Shapes(), []int{3, 3, 4}) {
+		t.Fatalf("Stack axis 2 shape: %v != [3 3 4]", st.Shapes())
+	}
+	if v := st.FloatValue([]int{1, 2, 3}); v != 35 {
+		t.Errorf("Stack axis 2 [1,2,3]: %v != 35", v)
+	}
+
+	tsrs[2] = NewFloat32([]int{3, 2}, nil, nil)
+	if _, err := StackTry(tsrs, 0); err == nil {
+		t.Errorf("StackTry: expected error for unequal shapes")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unsafe"
 
 	"github.com/emer/etable/v2/bitslice"
 	"gonum.org/v1/gonum/mat"
@@ -131,6 +132,25 @@ func (tsr *String) SetFloat1D(off int, val float64) {
 	tsr.Values[off] = Float64ToString(val)
 }
 
+// FloatValue1DTry returns the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *String) FloatValue1DTry(off int) (float64, error) {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return 0, err
+	}
+	return StringToFloat64(tsr.Values[off]), nil
+}
+
+// SetFloat1DTry sets the value of given 1-dimensional index (0-Len()-1) as a float64.
+// Try version returns an error if the index is out of range, instead of panicking.
+func (tsr *String) SetFloat1DTry(off int, val float64) error {
+	if err := tsr.Offset1DTry(off); err != nil {
+		return err
+	}
+	tsr.Values[off] = Float64ToString(val)
+	return nil
+}
+
 func (tsr *String) FloatValueRowCell(row, cell int) float64 {
 	_, sz := tsr.RowCellSize()
 	return StringToFloat64(tsr.Values[row*sz+cell])
@@ -174,6 +194,11 @@ func (tsr *String) Range() (min, max float64, minIndex, maxIndex int) {
 	return
 }
 
+// RowRange is not applicable to string tensors, and returns 0, 0
+func (tsr *String) RowRange(row int) (min, max float64) {
+	return
+}
+
 // Agg applies given aggregation function to each element in the tensor
 // (automatically skips IsNull and NaN elements), using float64 conversions of the values.
 // init is the initial value for the agg variable. returns final aggregate value
@@ -236,6 +261,24 @@ func (tsr *String) Clone() Tensor {
 	return csr
 }
 
+// Snapshot returns a copy of the tensor, as in Clone, that is always
+// internally consistent, with Values (and Nulls) of the length of its Shape,
+// even if the Values of this tensor are being resized at the same time
+// (any missing values are zero), and a copy of the meta data.
+// This is for safe display of data that is being updated in another
+// goroutine: for full protection against concurrent writes, the writer
+// and reader must also use a mutex, as in etable.Table.SnapshotLocked.
+func (tsr *String) Snapshot() Tensor {
+	vals, nulls := tsr.Values, tsr.Nulls // read once
+	csr := NewStringShape(&tsr.Shape)
+	copy(csr.Values, vals)
+	if nulls != nil {
+		csr.Nulls = snapshotBits(nulls, csr.Len())
+	}
+	csr.CopyMetaData(tsr)
+	return csr
+}
+
 // CopyFrom copies all avail values from other tensor into this tensor, with an
 // optimized implementation if the other tensor is of the same type, and
 // otherwise it goes through appropriate standard type.
@@ -289,6 +332,30 @@ func (tsr *String) CopyCellsFrom(frm Tensor, to, start, n int) {
 	}
 }
 
+// Gather returns a new tensor with a copy of only the given outer-most rows
+// of this tensor, in the given order (rows can be repeated), copying the
+// cell values for each row, including Null values and meta data.
+// Only valid for RowMajor organization.
+func (tsr *String) Gather(rows []int) *String {
+	_, csz := tsr.RowCellSize()
+	shp := CopyInts(tsr.Shp)
+	shp[0] = len(rows)
+	nt := NewString(shp, nil, tsr.Nms)
+	for i, r := range rows {
+		copy(nt.Values[i*csz:(i+1)*csz], tsr.Values[r*csz:(r+1)*csz])
+		if tsr.Nulls == nil {
+			continue
+		}
+		for j := 0; j < csz; j++ {
+			if tsr.Nulls.Index(r*csz + j) {
+				nt.SetNull1D(i*csz+j, true)
+			}
+		}
+	}
+	nt.CopyMetaData(tsr)
+	return nt
+}
+
 // SetShape sets the shape params, resizing backing storage appropriately
 func (tsr *String) SetShape(shape, strides []int, names []string) {
 	tsr.Shape.SetShape(shape, strides, names)
@@ -305,6 +372,18 @@ func (tsr *String) SetShape(shape, strides []int, names []string) {
 	}
 }
 
+// SetShapeSafe sets the shape params as in SetShape, but only if the new
+// shape has the same total length as the current one, and otherwise returns
+// an error without changing anything, so that no values are ever discarded,
+// e.g., when just relabeling or reorganizing the dimensions.
+func (tsr *String) SetShapeSafe(shape, strides []int, names []string) error {
+	if err := tsr.sameLenTry(shape); err != nil {
+		return err
+	}
+	tsr.SetShape(shape, strides, names)
+	return nil
+}
+
 // SetNumRows sets the number of rows (outer-most dimension) in a RowMajor organized tensor.
 func (tsr *String) SetNumRows(rows int) {
 	if !tsr.IsRowMajor() {
@@ -412,6 +491,17 @@ func (tsr *String) T() mat.Matrix {
 	return mat.Transpose{tsr}
 }
 
+// SizeBytes returns the approximate size in bytes of the backing storage
+// of the tensor, i.e., the Values, including the string headers and
+// contents, and any Nulls, for memory profiling.
+func (tsr *String) SizeBytes() int {
+	n := len(tsr.Values) * int(unsafe.Sizeof(""))
+	for _, s := range tsr.Values {
+		n += len(s)
+	}
+	return n + len(tsr.Nulls)
+}
+
 // Label satisfies the core.Labeler interface for a summary description of the tensor
 func (tsr *String) Label() string {
 	return fmt.Sprintf("String: %s", tsr.Shape.String())
@@ -479,3 +569,42 @@ func (tsr *String) CopyMetaData(frm Tensor) {
 		tsr.Meta[k] = v
 	}
 }
+
+// NaturalLess returns true if string a sorts before string b in natural
+// (numeric-aware) order, where runs of digits are compared by their numeric
+// value instead of character by character, so that "2" < "10" and
+// "item2" < "item10".  Other characters are compared byte-wise.
+// Equal numbers with more leading zeros sort after those with fewer.
+func NaturalLess(a, b string) bool {
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				return a[i] < b[j]
+			}
+			i++
+			j++
+			continue
+		}
+		si, sj := i, j
+		for i < len(a) && isDigit(a[i]) {
+			i++
+		}
+		for j < len(b) && isDigit(b[j]) {
+			j++
+		}
+		na := strings.TrimLeft(a[si:i], "0")
+		nb := strings.TrimLeft(b[sj:j], "0")
+		if len(na) != len(nb) {
+			return len(na) < len(nb)
+		}
+		if na != nb {
+			return na < nb
+		}
+		if i-si != j-sj {
+			return i-si < j-sj
+		}
+	}
+	return len(a)-i < len(b)-j
+}
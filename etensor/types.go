@@ -66,6 +66,15 @@ const (
 	INT Type = STRING + 1
 )
 
+// Numeric is the type constraint for the values of the numeric tensor
+// types, for generic functions with type-specialized fast paths that
+// operate directly on the Values, e.g., Clip, instead of going through
+// the float64 Tensor interface methods.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
 func (tp Type) IsNumeric() bool {
 	if (tp >= UINT8 && tp <= FLOAT64) || tp == INT {
 		return true
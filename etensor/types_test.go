@@ -0,0 +1,205 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etensor
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewTypes(t *testing.T) {
+	for _, typ := range TypeValues() {
+		tsr := New(typ, []int{2, 3}, nil, nil)
+		if typ == NULL || typ == FLOAT16 {
+			if tsr != nil {
+				t.Errorf("New(%v): expected nil for unsupported type", typ)
+			}
+			continue
+		}
+		if tsr == nil {
+			t.Errorf("New(%v): returned nil", typ)
+			continue
+		}
+		if tsr.DataType() != typ {
+			t.Errorf("New(%v): DataType %v", typ, tsr.DataType())
+		}
+		if tsr.Len() != 6 {
+			t.Errorf("New(%v): Len %d != 6", typ, tsr.Len())
+		}
+		var st Type
+		if err := st.SetString(typ.String()); err != nil || st != typ {
+			t.Errorf("Type %v: String / SetString round trip failed: %v", typ, err)
+		}
+	}
+}
+
+func TestFloat1DTry(t *testing.T) {
+	for _, typ := range TypeValues() {
+		tsr := New(typ, []int{2, 3}, nil, nil)
+		if tsr == nil {
+			continue
+		}
+		if err := tsr.SetFloat1DTry(5, 1); err != nil {
+			t.Errorf("%v: SetFloat1DTry(5): %v", typ, err)
+		}
+		if v, err := tsr.FloatValue1DTry(5); err != nil || v != 1 {
+			t.Errorf("%v: FloatValue1DTry(5): %g, %v", typ, v, err)
+		}
+		for _, off := range []int{-1, 6} {
+			if err := tsr.SetFloat1DTry(off, 1); err == nil {
+				t.Errorf("%v: SetFloat1DTry(%d): expected error", typ, off)
+			}
+			if _, err := tsr.FloatValue1DTry(off); err == nil {
+				t.Errorf("%v: FloatValue1DTry(%d): expected error", typ, off)
+			}
+		}
+	}
+}
+
+func TestRowRange(t *testing.T) {
+	tsr := NewFloat32([]int{3, 2, 2}, nil, nil)
+	tsr.SetFloats([]float64{
+		0, 1, 2, 3,
+		-5, 10, math.NaN(), 2,
+		math.NaN(), math.NaN(), math.NaN(), math.NaN(),
+	})
+	if mn, mx := tsr.RowRange(0); mn != 0 || mx != 3 {
+		t.Errorf("RowRange(0): %g, %g", mn, mx)
+	}
+	if mn, mx := tsr.RowRange(1); mn != -5 || mx != 10 {
+		t.Errorf("RowRange(1): %g, %g", mn, mx)
+	}
+	if mn, mx := tsr.RowRange(2); mn != 0 || mx != 0 {
+		t.Errorf("RowRange(2) all NaN: %g, %g", mn, mx)
+	}
+	if mn, mx := tsr.RowRange(3); mn != 0 || mx != 0 {
+		t.Errorf("RowRange(3) out of range: %g, %g", mn, mx)
+	}
+	it := NewInt([]int{2}, nil, nil)
+	it.SetFloats([]float64{4, 7})
+	if mn, mx := it.RowRange(1); mn != 7 || mx != 7 {
+		t.Errorf("Int RowRange(1): %g, %g", mn, mx)
+	}
+}
+
+func TestSetShapeSafe(t *testing.T) {
+	for _, typ := range TypeValues() {
+		tsr := New(typ, []int{2, 3}, nil, nil)
+		if tsr == nil {
+			continue
+		}
+		tsr.SetFloat1D(5, 1)
+		if err := tsr.SetShapeSafe([]int{3, 2}, nil, []string{"Y", "X"}); err != nil {
+			t.Errorf("%v: SetShapeSafe same length: %v", typ, err)
+		}
+		if tsr.Dim(0) != 3 || tsr.DimName(1) != "X" || tsr.FloatValue1D(5) != 1 {
+			t.Errorf("%v: SetShapeSafe did not reshape: %v", typ, tsr.Shapes())
+		}
+		if err := tsr.SetShapeSafe([]int{2, 2}, nil, nil); err == nil {
+			t.Errorf("%v: SetShapeSafe shrinking: expected error", typ)
+		}
+		if tsr.Len() != 6 || tsr.Dim(0) != 3 {
+			t.Errorf("%v: SetShapeSafe error changed shape: %v", typ, tsr.Shapes())
+		}
+		tsr.SetShape([]int{2, 2}, nil, nil)
+		if tsr.Len() != 4 {
+			t.Errorf("%v: SetShape shrinking: Len %d != 4", typ, tsr.Len())
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	for _, typ := range TypeValues() {
+		tsr := New(typ, []int{2, 3}, nil, nil)
+		if tsr == nil {
+			continue
+		}
+		tsr.SetFloat1D(1, 1)
+		tsr.SetMetaData("name", "act")
+		tsr.SetNumRows(3) // shape and values consistently resized
+		ss := tsr.Snapshot()
+		if ss.Len() != 9 || ss.FloatValue1D(1) != 1 || ss.FloatValue1D(8) != 0 {
+			t.Errorf("%v: Snapshot: len %d", typ, ss.Len())
+		}
+		if nm, _ := ss.MetaData("name"); nm != "act" {
+			t.Errorf("%v: Snapshot meta data: %q", typ, nm)
+		}
+		ss.SetFloat1D(1, 0)
+		if tsr.FloatValue1D(1) != 1 {
+			t.Errorf("%v: Snapshot shares values", typ)
+		}
+	}
+
+	// simulate a snapshot taken in the middle of a resize, where the
+	// shape has been updated but the values not yet
+	tsr := NewFloat32([]int{2, 3}, nil, nil)
+	tsr.SetNull1D(0, true)
+	tsr.Shape.Shp[0] = 4
+	ss := tsr.Snapshot().(*Float32)
+	if len(ss.Values) != ss.Len() || ss.Len() != 12 || ss.Nulls.Len() != 12 || !ss.IsNull1D(0) {
+		t.Errorf("Snapshot mid-resize not consistent: len(Values) %d, Len %d, Nulls %d", len(ss.Values), ss.Len(), ss.Nulls.Len())
+	}
+	tsr.Shape.Shp[0] = 1
+	ss = tsr.Snapshot().(*Float32)
+	if len(ss.Values) != ss.Len() || ss.Len() != 3 || ss.Nulls.Len() != 3 {
+		t.Errorf("Snapshot mid-shrink not consistent: len(Values) %d, Len %d", len(ss.Values), ss.Len())
+	}
+}
+
+func TestAddRowWise(t *testing.T) {
+	tsr := NewFloat64([]int{3, 2, 2}, nil, nil)
+	bias := NewFloat32([]int{2, 2}, nil, nil)
+	bias.SetFloats([]float64{1, 2, 3, 4})
+	tsr.SetNull1D(5, true)
+	if err := tsr.AddRowWise(bias); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range tsr.Values {
+		exp := float64(i%4 + 1)
+		if i == 5 {
+			exp = 0 // null not changed
+		}
+		if v != exp {
+			t.Errorf("AddRowWise: value %d: %g != %g", i, v, exp)
+		}
+	}
+
+	// bias with an outer row dimension of 1
+	bias1 := NewFloat64([]int{1, 2, 2}, nil, nil)
+	bias1.SetFloats([]float64{1, 1, 1, 1})
+	if err := tsr.AddRowWise(bias1); err != nil {
+		t.Fatal(err)
+	}
+	if tsr.Values[11] != 5 {
+		t.Errorf("AddRowWise 1-row bias: %g != 5", tsr.Values[11])
+	}
+
+	if err := tsr.AddRowWise(NewFloat64([]int{4}, nil, nil)); err == nil {
+		t.Error("AddRowWise: expected error for wrong bias shape")
+	}
+	if tsr.Values[0] != 2 {
+		t.Errorf("AddRowWise: values changed on error: %g", tsr.Values[0])
+	}
+}
+
+func TestNewFloat64FromSlice(t *testing.T) {
+	vals := []float64{1, 2, 3, 4, 5, 6}
+	tsr := NewFloat64FromSlice(vals, []int{2, 3})
+	if tsr.Dim(0) != 2 || tsr.Dim(1) != 3 || tsr.Value([]int{1, 2}) != 6 {
+		t.Errorf("NewFloat64FromSlice: %v", tsr)
+	}
+	vals[0] = 10
+	if tsr.Values[0] != 1 {
+		t.Error("NewFloat64FromSlice should copy values")
+	}
+	if tsr = NewFloat64FromSlice(vals, nil); tsr.NumDims() != 1 || tsr.Len() != 6 {
+		t.Errorf("NewFloat64FromSlice nil shape: %v", tsr.Shapes())
+	}
+	var flt []float64
+	tsr.Floats(&flt)
+	if flt[0] != 10 || flt[5] != 6 {
+		t.Errorf("NewFloat64FromSlice Floats round trip: %v", flt)
+	}
+}
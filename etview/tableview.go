@@ -7,16 +7,22 @@ package etview
 //go:generate core generate -add-types
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"image"
+	"io"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
 	"cogentcore.org/core/core"
 	"cogentcore.org/core/errors"
 	"cogentcore.org/core/events"
+	"cogentcore.org/core/fileinfo"
 	"cogentcore.org/core/icons"
+	"cogentcore.org/core/mimedata"
 	"cogentcore.org/core/reflectx"
 	"cogentcore.org/core/states"
 	"cogentcore.org/core/styles"
@@ -55,9 +61,12 @@ type TableView struct {
 	// HeaderWidths has number of characters in each header, per visfields
 	HeaderWidths []int `copier:"-" view:"-" json:"-" xml:"-"`
 
-	// ColMaxWidths records maximum width in chars of string type fields
+	// ColMaxWidths records maximum width in chars of the formatted values of 1D columns
 	ColMaxWidths []int `set:"-" copier:"-" json:"-" xml:"-"`
 
+	// the state of the table that each of the ColMaxWidths was computed for
+	colMaxKeys []colMaxKey
+
 	//	blank values for out-of-range rows
 	BlankString string
 	BlankFloat  float64
@@ -327,8 +336,7 @@ func (tv *TableView) ConfigRows() {
 			vvi := i*tv.NCols + fli
 			tags := ""
 			var vv views.Value
-			stsr, isstr := col.(*etensor.String)
-			if isstr {
+			if _, isstr := col.(*etensor.String); isstr {
 				vv = views.ToValue(&tv.BlankString, tags)
 				vv.SetSoloValue(reflect.ValueOf(&tv.BlankString))
 				if !tv.IsReadOnly() {
@@ -346,6 +354,9 @@ func (tv *TableView) ConfigRows() {
 				if col.NumDims() == 1 {
 					vv = views.ToValue(&tv.BlankFloat, "")
 					vv.SetSoloValue(reflect.ValueOf(&tv.BlankFloat))
+					if format := tv.ColTensorDisp(fli).FloatFormat(); format != "" {
+						vv.AsValueData().SetTag("format", format)
+					}
 					if !tv.IsReadOnly() {
 						vv.OnChange(func(e events.Event) {
 							tv.SetChanged()
@@ -381,17 +392,15 @@ func (tv *TableView) ConfigRows() {
 				tgw.Style(func(s *styles.Style) {
 					s.Grow.Set(0, 0)
 				})
-			}
-			if isstr && i == 0 && tv.SliceSize > 0 {
-				tv.ColMaxWidths[fli] = 0
-				mxw := 0
-				for _, ixi := range tv.Table.Indexes {
-					if ixi >= 0 {
-						sval := stsr.Values[ixi]
-						mxw = max(mxw, len(sval))
-					}
+				if !tv.IsReadOnly() {
+					tgw.OnChange(func(e events.Event) { // inline edits
+						tv.SetChanged()
+						tv.Table.Table.Changed()
+					})
 				}
-				tv.ColMaxWidths[fli] = mxw
+			}
+			if i == 0 && tv.SliceSize > 0 && col.NumDims() == 1 {
+				tv.updateColMaxWidth(fli, col)
 			}
 		}
 	}
@@ -399,6 +408,52 @@ func (tv *TableView) ConfigRows() {
 	tv.ApplyStyleTree()
 }
 
+// colMaxKey is the state of the table that a ColMaxWidths value is computed for
+type colMaxKey struct {
+	col     etensor.Tensor
+	version uint64
+	rows    int
+	format  string
+}
+
+// updateColMaxWidth updates the ColMaxWidths value of given 1D column,
+// only formatting all of its values again if the column, the table
+// Version, the number of rows in the view, or the format has changed.
+func (tv *TableView) updateColMaxWidth(fli int, col etensor.Tensor) {
+	if len(tv.colMaxKeys) != len(tv.ColMaxWidths) {
+		tv.colMaxKeys = make([]colMaxKey, len(tv.ColMaxWidths))
+	}
+	key := colMaxKey{col, tv.Table.Table.Version(), tv.Table.Len(), tv.ColTensorDisp(fli).FloatFormat()}
+	if tv.colMaxKeys[fli] == key {
+		return
+	}
+	tv.colMaxKeys[fli] = key
+	tv.ColMaxWidths[fli] = colMaxWidth(tv.Table, col, key.format)
+}
+
+// colMaxWidth returns the maximum width in chars of the values of given
+// 1D column over the rows of given view: the string values for a String
+// column, and otherwise the values formatted with given format string,
+// or the default %g formatting if empty, as shown in the value widgets.
+func colMaxWidth(ix *etable.IndexView, col etensor.Tensor, format string) int {
+	if format == "" {
+		format = "%g"
+	}
+	stsr, isstr := col.(*etensor.String)
+	mxw := 0
+	for _, ixi := range ix.Indexes {
+		if ixi < 0 || ixi >= col.Len() {
+			continue
+		}
+		if isstr {
+			mxw = max(mxw, len(stsr.Values[ixi]))
+		} else {
+			mxw = max(mxw, len(fmt.Sprintf(format, col.FloatValue1D(ixi))))
+		}
+	}
+	return mxw
+}
+
 // UpdateWidgets updates the row widget display to
 // represent the current state of the slice data,
 // including which range of data is being displayed.
@@ -506,12 +561,21 @@ func (tv *TableView) UpdateWidgets() {
 	sg.NeedsRender()
 }
 
-// ColTensorBlank returns tensor blanks for given tensor col
+// ColTensorBlank returns the blank cell tensor for given tensor col,
+// shown for rows without data, obtained from the etensor tensor pool
+// (see etensor.GetPooled).  A blank that no longer matches the cell shape
+// of the column, e.g., after changing the table, is returned to the pool,
+// as ConfigRows and UpdateWidgets replace it in all of the column widgets.
 func (tv *TableView) ColTensorBlank(cidx int, col etensor.Tensor) *etensor.Float64 {
+	csh := col.Shapes()[1:]
 	if ctb, has := tv.ColTsrBlank[cidx]; has {
-		return ctb
+		if slices.Equal(ctb.Shapes(), csh) {
+			return ctb
+		}
+		etensor.PutPooled(ctb)
 	}
-	ctb := etensor.NewFloat64Shape(col.ShapeObj(), nil)
+	ctb := etensor.GetPooled(csh)
+	copy(ctb.Nms, col.DimNames()[1:])
 	tv.ColTsrBlank[cidx] = ctb
 	return ctb
 }
@@ -771,7 +835,136 @@ func (tv *TableView) ConfigToolbar(tb *core.Toolbar) {
 	views.NewFuncButton(tb, tv.Table.FilterColName).SetText("Filter").SetIcon(icons.FilterAlt)
 	views.NewFuncButton(tb, tv.Table.Sequential).SetText("Unfilter").SetIcon(icons.FilterAltOff)
 	views.NewFuncButton(tb, tv.Table.OpenCSV).SetIcon(icons.Open)
-	views.NewFuncButton(tb, tv.Table.SaveCSV).SetIcon(icons.Save)
+	views.NewFuncButton(tb, tv.SaveViewCSV).SetText("Save CSV").SetIcon(icons.Save)
+	views.NewFuncButton(tb, tv.SaveSelectedCSV).SetText("Save selected").SetIcon(icons.Save)
+}
+
+// SaveViewCSV writes the rows of the table that are currently shown in the
+// view, respecting any filtering and sorting, to a comma-separated-values
+// (CSV) file (where comma = any delimiter, specified in the delim arg).
+// If headers = true then generate emergent-style column headers.
+func (tv *TableView) SaveViewCSV(filename core.Filename, delim etable.Delims, headers bool) error { //types:add
+	return tv.Table.SaveCSV(filename, delim, headers)
+}
+
+// SaveSelectedCSV writes only the currently selected rows of the table,
+// in view order, to a comma-separated-values (CSV) file (where comma =
+// any delimiter, specified in the delim arg).  If headers = true then
+// generate emergent-style column headers.  Returns an error if no rows
+// are selected.
+func (tv *TableView) SaveSelectedCSV(filename core.Filename, delim etable.Delims, headers bool) error { //types:add
+	if len(tv.SelectedIndexes) == 0 {
+		return errors.New("etview.TableView.SaveSelectedCSV: no rows are selected")
+	}
+	return tv.SelectedView().SaveCSV(filename, delim, headers)
+}
+
+// SelectedView returns a new IndexView onto the same table with the
+// currently selected rows, in view order.
+func (tv *TableView) SelectedView() *etable.IndexView {
+	return subView(tv.Table, tv.SelectedIndexesList(false)) // ascending
+}
+
+// subView returns a new IndexView with the rows of given view
+// at given indexes into the view.
+func subView(ix *etable.IndexView, idxs []int) *etable.IndexView {
+	sv := &etable.IndexView{Table: ix.Table, Indexes: make([]int, len(idxs))}
+	for i, di := range idxs {
+		sv.Indexes[i] = ix.Indexes[di]
+	}
+	return sv
+}
+
+// SelectRows selects the rows of the view that show the given source
+// table row indexes (e.g., the rows of points selected in a plot),
+// replacing any current selection, and scrolls to the first one.
+// Rows that are not in the view are ignored.
+func (tv *TableView) SelectRows(rows []int) {
+	idxs := viewIndexes(tv.Table, rows)
+	tv.ResetSelectedIndexes()
+	for _, i := range idxs {
+		tv.SelectedIndexes[i] = struct{}{}
+	}
+	if len(idxs) == 0 {
+		tv.SelectedIndex = -1
+	} else {
+		tv.SelectedIndex = idxs[0]
+		tv.ScrollToIndex(idxs[0])
+	}
+	tv.UpdateWidgets()
+	tv.NeedsRender()
+}
+
+// viewIndexes returns the sorted indexes into given view of the rows
+// showing the given source table row indexes, which need not be sorted.
+func viewIndexes(ix *etable.IndexView, rows []int) []int {
+	rmap := make(map[int]bool, len(rows))
+	for _, r := range rows {
+		rmap[r] = true
+	}
+	var idxs []int
+	for i, r := range ix.Indexes {
+		if rmap[r] {
+			idxs = append(idxs, i)
+		}
+	}
+	return idxs
+}
+
+// CopySelectToMime copies the selected rows to mime data as tab-separated
+// values with headers, with the values of 1D numeric columns formatted
+// with the display format of each column, as shown in the view
+// (see TensorDisp.FloatFormat).
+func (tv *TableView) CopySelectToMime() mimedata.Mimes {
+	if len(tv.SelectedIndexes) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	if err := tv.writeFormattedCSV(&b, tv.SelectedView()); err != nil {
+		errors.Log(err)
+		return nil
+	}
+	md := mimedata.NewTextBytes(b.Bytes())
+	md[0].Type = fileinfo.DataCsv
+	return md
+}
+
+// writeFormattedCSV writes the rows of given view onto our table as
+// tab-separated values with headers, as in etable.IndexView.WriteCSV,
+// but with the values of 1D numeric columns formatted with the
+// display format of each column.
+func (tv *TableView) writeFormattedCSV(w io.Writer, ix *etable.IndexView) error {
+	dt := ix.Table
+	cw := csv.NewWriter(w)
+	cw.Comma = etable.Tab.Rune()
+	if err := cw.Write(dt.EmerHeaders()); err != nil {
+		return err
+	}
+	formats := make([]string, dt.NumCols())
+	for ci, col := range dt.Cols {
+		if col.NumDims() == 1 && col.DataType() != etensor.STRING {
+			formats[ci] = tv.ColTensorDisp(ci).FloatFormat()
+		}
+	}
+	var rec []string
+	for _, row := range ix.Indexes {
+		rec = rec[:0]
+		for ci, col := range dt.Cols {
+			if formats[ci] != "" {
+				rec = append(rec, fmt.Sprintf(formats[ci], col.FloatValue1D(row)))
+				continue
+			}
+			_, csz := col.RowCellSize()
+			for i := row * csz; i < (row+1)*csz; i++ {
+				rec = append(rec, col.StringValue1D(i))
+			}
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
 }
 
 /*
@@ -826,7 +1019,10 @@ func (tv *TableView) PasteAssign(md mimedata.Mimes, idx int) {
 		return
 	}
 	updt := tv.UpdateStart()
-	tv.Table.Table.ReadCSVRow(recs[1], tv.Table.Indexes[idx])
+	if err := tv.Table.Table.ReadCSVRowTry(recs[1], tv.Table.Indexes[idx]); err != nil {
+		core.ErrorSnackbar(tv, err, "Pasted data does not match the columns")
+	}
+	tv.Table.Table.Changed()
 	tv.SetChanged()
 	tv.This().(views.SliceViewer).UpdateSliceGrid()
 	tv.UpdateEnd(updt)
@@ -844,11 +1040,18 @@ func (tv *TableView) PasteAtIndex(md mimedata.Mimes, idx int) {
 	defer tv.TopUpdateEnd(wupdt)
 	updt := tv.UpdateStart()
 	tv.Table.InsertRows(idx, nr)
+	var errs []error
 	for ri := 0; ri < nr; ri++ {
 		rec := recs[1+ri]
 		rw := tv.Table.Indexes[idx+ri]
-		tv.Table.Table.ReadCSVRow(rec, rw)
+		if err := tv.Table.Table.ReadCSVRowTry(rec, rw); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		core.ErrorSnackbar(tv, errors.Join(errs...), "Pasted data does not match the columns")
 	}
+	tv.Table.Table.Changed()
 	tv.SetChanged()
 	tv.This().(views.SliceViewer).UpdateSliceGrid()
 	tv.UpdateEnd(updt)
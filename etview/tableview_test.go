@@ -0,0 +1,203 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etview
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"cogentcore.org/core/core"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestSaveViewCSV(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+	}, 4)
+	for i, nm := range []string{"a", "b", "c", "d"} {
+		dt.SetCellString("Name", i, nm)
+	}
+	ix := etable.NewIndexView(dt)
+	ix.Indexes = []int{3, 1, 2} // filtered and sorted
+	tv := &TableView{Table: ix}
+
+	fn := filepath.Join(t.TempDir(), "view.csv")
+	if err := tv.SaveViewCSV(core.Filename(fn), etable.Comma, etable.NoHeaders); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Fields(string(b)); !slices.Equal(got, []string{"d", "b", "c"}) {
+		t.Errorf("SaveViewCSV: %v != [d b c]", got)
+	}
+
+	sv := subView(ix, []int{0, 2})
+	if sv.Table != dt || !slices.Equal(sv.Indexes, []int{3, 2}) {
+		t.Errorf("subView: %v != [3 2]", sv.Indexes)
+	}
+	if err := tv.SaveSelectedCSV(core.Filename(fn), etable.Comma, etable.NoHeaders); err == nil {
+		t.Error("SaveSelectedCSV: expected error with no selection")
+	}
+	if vi := viewIndexes(ix, []int{2, 0, 3}); !slices.Equal(vi, []int{0, 2}) {
+		t.Errorf("viewIndexes: %v != [0 2]", vi)
+	}
+}
+
+func TestColMaxWidth(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Value", etensor.FLOAT64, nil, nil},
+	}, 3)
+	dt.SetCellString("Name", 0, "abcde")
+	dt.SetCellFloat("Value", 0, 1)
+	dt.SetCellFloat("Value", 1, 123456.789)
+	dt.SetCellFloat("Value", 2, -2.5)
+	ix := etable.NewIndexView(dt)
+	if w := colMaxWidth(ix, dt.ColByName("Name"), ""); w != 5 {
+		t.Errorf("colMaxWidth string: %d != 5", w)
+	}
+	if w := colMaxWidth(ix, dt.ColByName("Value"), ""); w != len("123456.789") {
+		t.Errorf("colMaxWidth default format: %d != %d", w, len("123456.789"))
+	}
+	if w := colMaxWidth(ix, dt.ColByName("Value"), "%.4e"); w != len("-2.5000e+00") {
+		t.Errorf("colMaxWidth %%.4e format: %d != %d", w, len("-2.5000e+00"))
+	}
+	ix.Indexes = []int{0, 2}
+	if w := colMaxWidth(ix, dt.ColByName("Value"), ""); w != len("-2.5") {
+		t.Errorf("colMaxWidth filtered view: %d != %d", w, len("-2.5"))
+	}
+}
+
+func TestUpdateColMaxWidth(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Value", etensor.FLOAT64, nil, nil},
+	}, 2)
+	dt.SetCellFloat("Value", 0, 1)
+	tv := &TableView{Table: etable.NewIndexView(dt), ColTsrDisp: map[int]*TensorDisp{}, ColMaxWidths: make([]int, 1)}
+	tv.TsrDisp.Defaults()
+	col := dt.ColByName("Value")
+	tv.updateColMaxWidth(0, col)
+	if tv.ColMaxWidths[0] != 1 {
+		t.Errorf("updateColMaxWidth: %d != 1", tv.ColMaxWidths[0])
+	}
+	col.SetFloat1D(1, 123.5) // not recomputed until Changed
+	tv.updateColMaxWidth(0, col)
+	if tv.ColMaxWidths[0] != 1 {
+		t.Errorf("updateColMaxWidth without Changed: %d != 1", tv.ColMaxWidths[0])
+	}
+	dt.Changed()
+	tv.updateColMaxWidth(0, col)
+	if tv.ColMaxWidths[0] != len("123.5") {
+		t.Errorf("updateColMaxWidth after Changed: %d != %d", tv.ColMaxWidths[0], len("123.5"))
+	}
+	tv.Table.Filter(func(et *etable.Table, row int) bool { return row == 0 })
+	tv.updateColMaxWidth(0, col)
+	if tv.ColMaxWidths[0] != 1 {
+		t.Errorf("updateColMaxWidth filtered view: %d != 1", tv.ColMaxWidths[0])
+	}
+}
+
+func TestColPrecision(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Value", etensor.FLOAT64, nil, nil},
+		{"Other", etensor.FLOAT64, nil, nil},
+	}, 2)
+	dt.SetCellFloat("Value", 0, 1.23456)
+	dt.SetCellFloat("Value", 1, -20)
+	dt.ColByName("Value").SetMetaData("precision", "3")
+	tv := &TableView{Table: etable.NewIndexView(dt), ColTsrDisp: map[int]*TensorDisp{}}
+	tv.TsrDisp.Defaults()
+
+	format := tv.ColTensorDisp(0).FloatFormat()
+	if format != "%.3f" {
+		t.Errorf("precision 3 format: %q != %%.3f", format)
+	}
+	if s := fmt.Sprintf(format, dt.CellFloat("Value", 0)); s != "1.235" {
+		t.Errorf("precision 3 value: %q != 1.235", s)
+	}
+	if w := colMaxWidth(tv.Table, dt.ColByName("Value"), format); w != len("-20.000") {
+		t.Errorf("precision 3 colMaxWidth: %d != %d", w, len("-20.000"))
+	}
+	if format := tv.ColTensorDisp(1).FloatFormat(); format != "" {
+		t.Errorf("default format: %q != empty", format)
+	}
+
+	tv.TsrDisp.Precision = 2 // global default
+	if format := tv.ColTensorDisp(1).FloatFormat(); format != "%.2f" {
+		t.Errorf("global precision format: %q != %%.2f", format)
+	}
+	td := &TensorDisp{Format: "%.1e", Precision: 3}
+	if format := td.FloatFormat(); format != "%.1e" {
+		t.Errorf("Format overrides Precision: %q != %%.1e", format)
+	}
+}
+
+func TestColFormatCopy(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Value", etensor.FLOAT64, nil, nil},
+		{"Other", etensor.FLOAT64, nil, nil},
+	}, 3)
+	for i, v := range []float64{1.23456, -20, 0.5} {
+		dt.SetCellString("Name", i, string(rune('a'+i)))
+		dt.SetCellFloat("Value", i, v)
+		dt.SetCellFloat("Other", i, v)
+	}
+	dt.ColByName("Value").SetMetaData("format", "%.2e")
+	tv := &TableView{Table: etable.NewIndexView(dt), ColTsrDisp: map[int]*TensorDisp{}}
+	tv.TsrDisp.Defaults()
+	if td := tv.ColTensorDisp(1); td.Format != "%.2e" || td.FloatFormat() != "%.2e" {
+		t.Errorf("format meta data: TensorDisp.Format: %q != %%.2e", td.Format)
+	}
+
+	tv.SliceSize = dt.Rows
+	tv.SelectedIndexes = map[int]struct{}{2: {}, 0: {}}
+	md := tv.CopySelectToMime()
+	if len(md) != 1 {
+		t.Fatalf("CopySelectToMime: %d mime data != 1", len(md))
+	}
+	exp := "$Name\t#Value\t#Other\na\t1.23e+00\t1.23456\nc\t5.00e-01\t0.5\n"
+	if got := string(md[0].Data); got != exp {
+		t.Errorf("CopySelectToMime:\n%q\n!=\n%q", got, exp)
+	}
+	tv.SelectedIndexes = map[int]struct{}{}
+	if tv.CopySelectToMime() != nil {
+		t.Error("CopySelectToMime: expected nil with no selection")
+	}
+}
+
+func TestColTensorBlank(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Input", etensor.FLOAT32, []int{2, 3}, []string{"Y", "X"}},
+	}, 4)
+	tv := &TableView{ColTsrBlank: map[int]*etensor.Float64{}}
+	ctb := tv.ColTensorBlank(0, dt.Cols[0])
+	if !slices.Equal(ctb.Shapes(), []int{2, 3}) || !slices.Equal(ctb.DimNames(), []string{"Y", "X"}) {
+		t.Errorf("ColTensorBlank: shape: %v names: %v != cell shape", ctb.Shapes(), ctb.DimNames())
+	}
+	dt.AddRows(2)
+	if tv.ColTensorBlank(0, dt.Cols[0]) != ctb {
+		t.Error("ColTensorBlank: blank should be re-used when the cell shape is unchanged")
+	}
+	nt := etable.New(etable.Schema{
+		{"Input", etensor.FLOAT32, []int{4}, nil},
+	}, 4)
+	nb := tv.ColTensorBlank(0, nt.Cols[0])
+	if !slices.Equal(nb.Shapes(), []int{4}) {
+		t.Errorf("ColTensorBlank: shape after table change: %v != [4]", nb.Shapes())
+	}
+	for i, v := range nb.Values {
+		if v != 0 {
+			t.Errorf("ColTensorBlank: value %d: %v != 0", i, v)
+		}
+	}
+}
@@ -5,8 +5,10 @@
 package etview
 
 import (
+	"image"
 	"image/color"
 	"log"
+	"sort"
 	"strconv"
 
 	"cogentcore.org/core/colors"
@@ -67,6 +69,21 @@ type TensorDisp struct { //types:add
 	// font size in standard point units for labels (e.g., SimMat)
 	FontSize float32
 
+	// format string for displaying scalar float values in a TableView,
+	// e.g., %.4f or %.2e -- default formatting is used if empty
+	Format string
+
+	// number of decimal digits for displaying scalar float values in a
+	// TableView, e.g., 3 for 1.235 -- only used if Format is empty, and
+	// default formatting is used if 0.  Set from "precision" column meta data.
+	Precision int
+
+	// maximum number of values in a tensor for editing the values inline,
+	// in a popup grid of value fields anchored to the tensor grid, instead
+	// of a separate window, e.g., for small pattern cells in a TableView.
+	// Set to a negative value to disable inline editing.
+	InlineMax int `default:"16"`
+
 	// our gridview, for update method
 	GridView *TensorGrid `copier:"-" json:"-" xml:"-" view:"-"`
 }
@@ -96,6 +113,32 @@ func (td *TensorDisp) Defaults() {
 	if td.FontSize == 0 {
 		td.FontSize = 24
 	}
+	if td.InlineMax == 0 {
+		td.InlineMax = 16
+	}
+}
+
+// UseInlineEdit returns true if the values of the given tensor should
+// be edited inline, i.e., it has at most InlineMax values.
+func (td *TensorDisp) UseInlineEdit(tsr etensor.Tensor) bool {
+	if tsr == nil || td.InlineMax <= 0 {
+		return false
+	}
+	n := tsr.Len()
+	return n > 0 && n <= td.InlineMax
+}
+
+// FloatFormat returns the format string for displaying scalar float values:
+// the Format if set, otherwise a fixed number of decimal digits if
+// Precision > 0, or empty for the default formatting.
+func (td *TensorDisp) FloatFormat() string {
+	if td.Format != "" {
+		return td.Format
+	}
+	if td.Precision > 0 {
+		return "%." + strconv.Itoa(td.Precision) + "f"
+	}
+	return ""
 }
 
 // FromMeta sets display options from Tensor meta-data
@@ -137,6 +180,13 @@ func (td *TensorDisp) FromMeta(tsr etensor.Tensor) {
 			td.Range.FixMax = false
 		}
 	}
+	if op, has := tsr.MetaData("format"); has {
+		td.Format = op
+	}
+	if op, has := tsr.MetaData("precision"); has {
+		mv, _ := strconv.Atoi(op)
+		td.Precision = mv
+	}
 	if op, has := tsr.MetaData("colormap"); has {
 		td.ColorMap = views.ColorMapName(op)
 	}
@@ -160,6 +210,10 @@ func (td *TensorDisp) FromMeta(tsr etensor.Tensor) {
 		mv, _ := strconv.ParseFloat(op, 32)
 		td.FontSize = float32(mv)
 	}
+	if op, has := tsr.MetaData("inline-max"); has {
+		mv, _ := strconv.Atoi(op)
+		td.InlineMax = mv
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////
@@ -210,8 +264,13 @@ func (tg *TensorGrid) SetTensor(tsr etensor.Tensor) *TensorGrid {
 	return tg
 }
 
-// OpenTensorView pulls up a TensorView of our tensor
+// OpenTensorView pulls up a TensorView of our tensor, or edits the values
+// inline via OpenInlineEdit if it is small enough, per Disp.InlineMax.
 func (tg *TensorGrid) OpenTensorView() {
+	if !tg.IsReadOnly() && tg.Disp.UseInlineEdit(tg.Tensor) {
+		tg.OpenInlineEdit()
+		return
+	}
 	/*
 		dlg := TensorViewDialog(tg.ViewportSafe(), tg.Tensor, views.DlgOpts{Title: "Edit Tensor", Prompt: "", NoAdd: true, NoDelete: true}, nil, nil)
 		tvk := dlg.Frame().ChildByType(KiT_TensorView, true, 2)
@@ -227,15 +286,88 @@ func (tg *TensorGrid) OpenTensorView() {
 	*/
 }
 
+// OpenInlineEdit opens a popup anchored below the grid, with a value field
+// for each value of our tensor, laid out as in the grid display, for quickly
+// editing small tensors without a separate window.  The values are set
+// directly in the tensor, and a Change event is sent for each edit.
+func (tg *TensorGrid) OpenInlineEdit() {
+	tsr := tg.Tensor
+	if tsr == nil || tsr.Len() == 0 {
+		return
+	}
+	rows, cols, _, _ := etensor.Prjn2DShape(tsr.ShapeObj(), tg.Disp.OddRow)
+	d := core.NewBody("tensor-inline-edit")
+	fr := core.NewFrame(d)
+	fr.Style(func(s *styles.Style) {
+		s.Display = styles.Grid
+		s.Columns = cols
+	})
+	for y := 0; y < rows; y++ {
+		ey := y
+		if !tg.Disp.TopZero {
+			ey = (rows - 1) - y
+		}
+		for x := 0; x < cols; x++ {
+			x := x
+			sp := core.NewSpinner(fr).SetValue(float32(etensor.Prjn2DValue(tsr, tg.Disp.OddRow, ey, x)))
+			sp.Style(func(s *styles.Style) {
+				s.Min.X.Ch(8)
+			})
+			sp.OnChange(func(e events.Event) {
+				etensor.Prjn2DSet(tsr, tg.Disp.OddRow, ey, x, float64(sp.Value))
+				tg.SendChange(e)
+				tg.NeedsRender()
+			})
+		}
+	}
+	bb := tg.Geom.TotalBBox
+	core.NewMenuStage(d.Scene, tg, image.Pt(bb.Min.X, bb.Max.Y)).Run()
+}
+
 func (tg *TensorGrid) HandleEvents() {
 	tg.OnDoubleClick(func(e events.Event) {
 		tg.OpenTensorView()
 	})
 	tg.AddContextMenu(func(m *core.Scene) { // todo: still not getting the context menu event at all
 		views.NewFuncButton(m, tg.EditSettings).SetIcon(icons.Edit)
+		core.NewButton(m).SetText("Color map").SetIcon(icons.Palette).SetMenu(func(m *core.Scene) {
+			for _, nm := range ColorMapNames() {
+				core.NewButton(m).SetText(nm).OnClick(func(e events.Event) {
+					tg.SetColorMapName(nm)
+				})
+			}
+		})
+		core.NewButton(m).SetText("Toggle image").SetIcon(icons.Image).OnClick(func(e events.Event) {
+			tg.ToggleImage()
+		})
 	})
 }
 
+// ColorMapNames returns the sorted names of all the available color maps
+func ColorMapNames() []string {
+	nms := make([]string, 0, len(colormap.AvailableMaps))
+	for nm := range colormap.AvailableMaps {
+		nms = append(nms, nm)
+	}
+	sort.Strings(nms)
+	return nms
+}
+
+// SetColorMapName sets the color map to use for display by name,
+// updating the display options, and triggers a re-render.
+func (tg *TensorGrid) SetColorMapName(name string) {
+	tg.Disp.ColorMap = views.ColorMapName(name)
+	tg.EnsureColorMap()
+	tg.NeedsRender()
+}
+
+// ToggleImage toggles the Image display mode, displaying the tensor
+// as a bitmap image instead of a grid, and triggers a layout update.
+func (tg *TensorGrid) ToggleImage() {
+	tg.Disp.Image = !tg.Disp.Image
+	tg.NeedsLayout()
+}
+
 func (tg *TensorGrid) EditSettings() { //types:add
 	d := core.NewBody().AddTitle("Tensor Grid Display Options")
 	views.NewStructView(d).SetStruct(&tg.Disp).
@@ -0,0 +1,65 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package etview
+
+import (
+	"testing"
+
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestTensorGridColorMap(t *testing.T) {
+	tg := &TensorGrid{}
+	tg.Disp.Defaults()
+	tg.Tensor = etensor.NewFloat32([]int{2, 2}, nil, nil)
+	tg.EnsureColorMap()
+	_, c1 := tg.Color(0.5)
+
+	nms := ColorMapNames()
+	if len(nms) < 2 {
+		t.Fatalf("ColorMapNames: too few color maps: %v", nms)
+	}
+	other := nms[0]
+	if other == string(tg.Disp.ColorMap) {
+		other = nms[1]
+	}
+	tg.SetColorMapName(other)
+	if string(tg.Disp.ColorMap) != other || tg.ColorMap.Name != other {
+		t.Errorf("SetColorMapName: color map: %v / %v != %v", tg.Disp.ColorMap, tg.ColorMap.Name, other)
+	}
+	_, c2 := tg.Color(0.5)
+	r1, g1, b1, _ := c1.RGBA()
+	r2, g2, b2, _ := c2.RGBA()
+	if r1 == r2 && g1 == g2 && b1 == b2 {
+		t.Errorf("SetColorMapName: cell color did not change: %v == %v", c1, c2)
+	}
+
+	tg.ToggleImage()
+	if !tg.Disp.Image {
+		t.Errorf("ToggleImage: Image not set")
+	}
+}
+
+func TestUseInlineEdit(t *testing.T) {
+	td := &TensorDisp{}
+	td.Defaults()
+	small := etensor.NewFloat32([]int{2, 3}, nil, nil)
+	big := etensor.NewFloat32([]int{5, 5}, nil, nil)
+	if !td.UseInlineEdit(small) {
+		t.Errorf("UseInlineEdit: expected true for %d values with InlineMax: %d", small.Len(), td.InlineMax)
+	}
+	if td.UseInlineEdit(big) {
+		t.Errorf("UseInlineEdit: expected false for %d values with InlineMax: %d", big.Len(), td.InlineMax)
+	}
+	big.SetMetaData("inline-max", "25")
+	td.FromMeta(big)
+	if !td.UseInlineEdit(big) {
+		t.Errorf("UseInlineEdit: expected true for inline-max meta data: %d", td.InlineMax)
+	}
+	td.InlineMax = -1
+	if td.UseInlineEdit(small) {
+		t.Errorf("UseInlineEdit: expected false when disabled")
+	}
+}
@@ -5,6 +5,7 @@ go 1.22
 require (
 	cogentcore.org/core v0.1.0
 	github.com/apache/arrow/go/arrow v0.0.0-20211112161151-bc219186db40
+	github.com/mattn/go-sqlite3 v1.14.33
 	gonum.org/v1/gonum v0.14.0
 	gonum.org/v1/plot v0.14.0
 )
@@ -0,0 +1,102 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pca
+
+import (
+	"math"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/metric"
+	"github.com/emer/etable/v2/simat"
+)
+
+// DistTableCol generates a distance / similarity matrix from given column name
+// in given IndexView of an etable.Table, and given metric function.
+// In contrast to a Covariance matrix, which compares cells across rows,
+// this computes the *cell-wise* vector distances for each pairwise combination
+// of rows -- the result is a rows x rows symmetric matrix, which is
+// suitable as input to clustering (see clust package).
+// The column can be scalar or have n-dimensional cells.
+// It uses simat.TableColMatrix, which is also used for simat.SimMat.
+func DistTableCol(dmat etensor.Tensor, ix *etable.IndexView, colNm string, mfun metric.Func64) error {
+	return simat.TableColMatrix(dmat, ix, colNm, mfun)
+}
+
+// DistTableColStd generates a distance / similarity matrix from given column name
+// in given IndexView of an etable.Table, and given standard metric function.
+// See DistTableCol for more info.
+// This Std version is usable e.g., in Python where the func cannot be passed.
+func DistTableColStd(dmat etensor.Tensor, ix *etable.IndexView, colNm string, met metric.StdMetrics) error {
+	return DistTableCol(dmat, ix, colNm, metric.StdFunc64(met))
+}
+
+// DistMatrixTry returns a new rows x rows symmetric distance / similarity
+// matrix from given column name in given IndexView of an etable.Table,
+// using given standard metric (e.g., Euclidean, InvCosine, InvCorrelation
+// for distances, or Cosine, Correlation for similarities).
+// Returns error if column name not found or there are no rows.
+func DistMatrixTry(ix *etable.IndexView, colNm string, met metric.StdMetrics) (*etensor.Float64, error) {
+	dmat := &etensor.Float64{}
+	err := DistTableColStd(dmat, ix, colNm, met)
+	if err != nil {
+		return nil, err
+	}
+	return dmat, nil
+}
+
+// DistMatrix returns a new rows x rows symmetric distance / similarity
+// matrix from given column name in given IndexView of an etable.Table,
+// using given standard metric (e.g., Euclidean, InvCosine, InvCorrelation
+// for distances, or Cosine, Correlation for similarities).
+// Returns nil if column name not found or there are no rows -- see Try version.
+func DistMatrix(ix *etable.IndexView, colNm string, met metric.StdMetrics) *etensor.Float64 {
+	dmat, _ := DistMatrixTry(ix, colNm, met)
+	return dmat
+}
+
+// CosineSimTry returns a new rows x rows symmetric cosine similarity matrix
+// of the n-dimensional cells of given column name in given IndexView of an
+// etable.Table, as used in representational similarity analysis (RSA).
+// In contrast to the Cosine metric in DistMatrix, the similarity for any
+// row with an all-zero vector (for which the cosine is undefined) is NaN,
+// including with itself.
+// Returns error if column name not found or there are no rows.
+func CosineSimTry(ix *etable.IndexView, colNm string) (*etensor.Float64, error) {
+	smat := &etensor.Float64{}
+	err := DistTableCol(smat, ix, colNm, cosineSimNaN)
+	if err != nil {
+		return nil, err
+	}
+	return smat, nil
+}
+
+// CosineSim returns a new rows x rows symmetric cosine similarity matrix
+// of the n-dimensional cells of given column name in given IndexView of an
+// etable.Table -- see CosineSimTry for more info.
+// Returns nil if column name not found or there are no rows -- see Try version.
+func CosineSim(ix *etable.IndexView, colNm string) *etensor.Float64 {
+	smat, _ := CosineSimTry(ix, colNm)
+	return smat
+}
+
+// cosineSimNaN returns metric.Cosine64 of given vectors,
+// or NaN if either vector has all zero (or NaN) values.
+func cosineSimNaN(a, b []float64) float64 {
+	if isZeroVec(a) || isZeroVec(b) {
+		return math.NaN()
+	}
+	return metric.Cosine64(a, b)
+}
+
+// isZeroVec returns true if all the non-NaN values in given vector are 0
+func isZeroVec(vec []float64) bool {
+	for _, v := range vec {
+		if v != 0 && !math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pca
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+	"github.com/emer/etable/v2/metric"
+)
+
+func TestDistMatrix(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"vec", etensor.FLOAT64, []int{2}, nil},
+	}, 3)
+	dt.Cols[0].SetFloats([]float64{0, 0, 3, 4, 0, 1})
+	ix := etable.NewIndexView(dt)
+
+	dm := DistMatrix(ix, "vec", metric.Euclidean)
+	if dm == nil {
+		t.Fatal("DistMatrix returned nil")
+	}
+	if dm.Dim(0) != 3 || dm.Dim(1) != 3 {
+		t.Fatalf("DistMatrix shape: %v", dm.Shapes())
+	}
+	errtol := 1.0e-9
+	if math.Abs(dm.Value([]int{0, 1})-5) > errtol || math.Abs(dm.Value([]int{1, 0})-5) > errtol {
+		t.Errorf("DistMatrix Euclidean [0,1]: %v != 5", dm.Value([]int{0, 1}))
+	}
+	if math.Abs(dm.Value([]int{2, 1})-math.Sqrt(18)) > errtol {
+		t.Errorf("DistMatrix Euclidean [2,1]: %v != %v", dm.Value([]int{2, 1}), math.Sqrt(18))
+	}
+	for i := 0; i < 3; i++ {
+		if dm.Value([]int{i, i}) != 0 {
+			t.Errorf("DistMatrix Euclidean diag %d: %v != 0", i, dm.Value([]int{i, i}))
+		}
+	}
+
+	ix.Indexes = []int{2, 1}
+	dm = DistMatrix(ix, "vec", metric.Cosine)
+	if dm.Dim(0) != 2 {
+		t.Fatalf("DistMatrix view shape: %v", dm.Shapes())
+	}
+	if math.Abs(dm.Value([]int{0, 1})-0.8) > errtol {
+		t.Errorf("DistMatrix Cosine [0,1]: %v != 0.8", dm.Value([]int{0, 1}))
+	}
+
+	_, err := DistMatrixTry(ix, "novec", metric.Euclidean)
+	if err == nil {
+		t.Error("DistMatrixTry: expected error for missing column")
+	}
+}
+
+func TestCosineSim(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"vec", etensor.FLOAT64, []int{2}, nil},
+	}, 4)
+	dt.Cols[0].SetFloats([]float64{1, 0, 3, 4, 0, 0, 0, 2})
+	ix := etable.NewIndexView(dt)
+
+	sm := CosineSim(ix, "vec")
+	if sm == nil || sm.Dim(0) != 4 || sm.Dim(1) != 4 {
+		t.Fatal("CosineSim: wrong shape")
+	}
+	errtol := 1.0e-9
+	if math.Abs(sm.Value([]int{0, 1})-0.6) > errtol || math.Abs(sm.Value([]int{1, 3})-0.8) > errtol {
+		t.Errorf("CosineSim: [0,1] %v != 0.6, [1,3] %v != 0.8", sm.Value([]int{0, 1}), sm.Value([]int{1, 3}))
+	}
+	if math.Abs(sm.Value([]int{0, 3})) > errtol {
+		t.Errorf("CosineSim orthogonal [0,3]: %v != 0", sm.Value([]int{0, 3}))
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			v := sm.Value([]int{i, j})
+			if i == 2 || j == 2 {
+				if !math.IsNaN(v) {
+					t.Errorf("CosineSim zero vector [%d,%d]: %v != NaN", i, j, v)
+				}
+				continue
+			}
+			if v != sm.Value([]int{j, i}) {
+				t.Errorf("CosineSim not symmetric at [%d,%d]", i, j)
+			}
+			if i == j && math.Abs(v-1) > errtol {
+				t.Errorf("CosineSim diag %d: %v != 1", i, v)
+			}
+		}
+	}
+	if _, err := CosineSimTry(ix, "novec"); err == nil {
+		t.Error("CosineSimTry: expected error for missing column")
+	}
+}
@@ -53,34 +53,64 @@ func (smat *SimMat) TableCol(ix *etable.IndexView, colNm, labNm string, blankRep
 		return err
 	}
 	smat.Init()
-	sm := smat.Mat
-
 	rows := ix.Len()
-	nd := col.NumDims()
-	if nd < 2 || rows == 0 {
+	if col.NumDims() < 2 || rows == 0 {
 		return fmt.Errorf("simat.Tensor: must have 2 or more dims and rows != 0")
 	}
-	ln := col.Len()
-	sz := ln / col.Dim(0) // size of cell
+	if err := TableColMatrix(smat.Mat, ix, colNm, mfun); err != nil {
+		return err
+	}
+
+	if labNm == "" {
+		return nil
+	}
+	lc, err := ix.Table.ColByNameTry(labNm)
+	if err != nil {
+		return err
+	}
+	smat.Rows = make([]string, rows)
+	last := ""
+	for r := 0; r < rows; r++ {
+		lbl := lc.StringValue1D(ix.Indexes[r])
+		if blankRepeat && lbl == last {
+			continue
+		}
+		smat.Rows[r] = lbl
+		last = lbl
+	}
+	smat.Cols = smat.Rows // identical
+	return nil
+}
+
+// TableColMatrix sets the given tensor to the rows x rows symmetric
+// similarity / distance matrix of the cells of given column name in given
+// IndexView of an etable.Table, for each pairwise combination of rows,
+// using given metric function, which must be symmetric.  The column can be
+// scalar or have n-dimensional cells.  The name and desc meta data of the
+// matrix are set from the table and column.
+func TableColMatrix(sm etensor.Tensor, ix *etable.IndexView, colNm string, mfun metric.Func64) error {
+	col, err := ix.Table.ColByNameTry(colNm)
+	if err != nil {
+		return err
+	}
+	rows := ix.Len()
+	if rows == 0 {
+		return fmt.Errorf("simat.TableColMatrix: rows == 0")
+	}
+	_, sz := col.RowCellSize()
 
 	sshp := []int{rows, rows}
 	sm.SetShape(sshp, nil, nil)
 
 	av := make([]float64, sz)
 	bv := make([]float64, sz)
-	ardim := []int{0}
-	brdim := []int{0}
 	sdim := []int{0, 0}
 	for ai := 0; ai < rows; ai++ {
-		ardim[0] = ix.Indexes[ai]
 		sdim[0] = ai
-		ar := col.SubSpace(ardim)
-		ar.Floats(&av)
+		cellVec(av, col, ix.Indexes[ai])
 		for bi := 0; bi <= ai; bi++ { // lower diag
-			brdim[0] = ix.Indexes[bi]
 			sdim[1] = bi
-			br := col.SubSpace(brdim)
-			br.Floats(&bv)
+			cellVec(bv, col, ix.Indexes[bi])
 			sv := mfun(av, bv)
 			sm.SetFloat(sdim, sv)
 		}
@@ -107,26 +137,17 @@ func (smat *SimMat) TableCol(ix *etable.IndexView, colNm, labNm string, blankRep
 	if ds, has := ix.Table.MetaData["desc"]; has {
 		sm.SetMetaData("desc", ds)
 	}
+	return nil
+}
 
-	if labNm == "" {
-		return nil
-	}
-	lc, err := ix.Table.ColByNameTry(labNm)
-	if err != nil {
-		return err
+// cellVec extracts the cell values of given column at given table row
+// into vec, which must be of the size of the column cell.
+func cellVec(vec []float64, col etensor.Tensor, row int) {
+	sz := len(vec)
+	coff := row * sz
+	for ci := 0; ci < sz; ci++ {
+		vec[ci] = col.FloatValue1D(coff + ci)
 	}
-	smat.Rows = make([]string, rows)
-	last := ""
-	for r := 0; r < rows; r++ {
-		lbl := lc.StringValue1D(ix.Indexes[r])
-		if blankRepeat && lbl == last {
-			continue
-		}
-		smat.Rows[r] = lbl
-		last = lbl
-	}
-	smat.Cols = smat.Rows // identical
-	return nil
 }
 
 // BlankRepeat returns string slice with any sequentially repeated strings blanked out
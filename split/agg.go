@@ -6,9 +6,11 @@ package split
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/emer/etable/v2/agg"
 	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
 )
 
 // AggIndex performs aggregation using given standard aggregation function across
@@ -61,6 +63,115 @@ func AggAllNumericCols(spl *etable.Splits, aggTyp agg.Aggs) {
 	}
 }
 
+// AggNameIndex performs aggregation using the aggregation function registered
+// under given name (see agg.Register) across all splits, and returns the
+// SplitAgg container of the results, which are also stored in the Splits,
+// with the registered name, for use in AggsToTable.
+// Column is specified by index.  Returns an error if the name is not registered.
+func AggNameIndex(spl *etable.Splits, colIndex int, aggName string) (*etable.SplitAgg, error) {
+	fn, ok := agg.ByName(aggName)
+	if !ok {
+		return nil, fmt.Errorf("split.AggName: aggregation named: %q not registered", aggName)
+	}
+	dt := spl.Table()
+	if dt == nil {
+		return nil, fmt.Errorf("split.AggName: No splits to aggregate over")
+	}
+	nm, _ := agg.RegisteredName(aggName)
+	colNm := dt.ColNames[colIndex]
+	ag := spl.AddAgg(nm, colIndex)
+	for _, sp := range spl.Splits {
+		ag.Aggs = append(ag.Aggs, fn(sp, colNm))
+	}
+	return ag, nil
+}
+
+// AggName performs aggregation using the aggregation function registered
+// under given name (see agg.Register) across all splits, and returns the
+// SplitAgg container of the results, which are also stored in the Splits.
+// Column is specified by name.  Returns an error for a bad column name
+// or if the aggregation name is not registered.
+func AggName(spl *etable.Splits, colNm string, aggName string) (*etable.SplitAgg, error) {
+	dt := spl.Table()
+	if dt == nil {
+		return nil, fmt.Errorf("split.AggName: No splits to aggregate over")
+	}
+	colIndex, err := dt.ColIndexTry(colNm)
+	if err != nil {
+		return nil, err
+	}
+	return AggNameIndex(spl, colIndex, aggName)
+}
+
+// AggCols performs the aggregation registered under given name (see
+// agg.Register), e.g., Mean, across all splits for each of the given columns,
+// adding a SplitAgg for each column in order, which are returned, and are
+// all included in AggsToTable.  Returns an error, without aggregating,
+// if any column name is not found or the aggregation name is not registered.
+func AggCols(spl *etable.Splits, colNms []string, aggName string) ([]*etable.SplitAgg, error) {
+	fn, ok := agg.ByName(aggName)
+	if !ok {
+		return nil, fmt.Errorf("split.AggCols: aggregation named: %q not registered", aggName)
+	}
+	dt := spl.Table()
+	if dt == nil {
+		return nil, fmt.Errorf("split.AggCols: No splits to aggregate over")
+	}
+	cis, err := dt.ColIndexesByNamesTry(colNms)
+	if err != nil {
+		return nil, err
+	}
+	nm, _ := agg.RegisteredName(aggName)
+	ags := make([]*etable.SplitAgg, len(cis))
+	for i, ci := range cis {
+		ags[i] = spl.AddAgg(nm, ci)
+	}
+	for _, sp := range spl.Splits {
+		for i, cn := range colNms {
+			ags[i].Aggs = append(ags[i].Aggs, fn(sp, cn))
+		}
+	}
+	return ags, nil
+}
+
+// AddAggColumn adds a new Float64 column with given name to the table of
+// the splits, with the results of given aggregation for each split written
+// into every row of that split, e.g., the group mean for each row, which
+// can then be subtracted to compute group-mean-centered values.
+// The aggregation is specified as in Splits.AggByColName (e.g., Val:Mean),
+// or by the aggregation name alone (e.g., Mean), which uses the first
+// aggregation with that name.  The column has the same cell shape as the aggregated column,
+// and rows not in any split are NaN.  Returns an error if the aggregation
+// is not found or the table already has a column with the new name.
+func AddAggColumn(spl *etable.Splits, aggName, newColName string) error {
+	dt := spl.Table()
+	if dt == nil {
+		return fmt.Errorf("split.AddAggColumn: No splits to aggregate over")
+	}
+	ag := spl.AggByColName(aggName)
+	if ag == nil {
+		ag = spl.AggByName(aggName)
+	}
+	if ag == nil || len(ag.Aggs) != len(spl.Splits) {
+		return fmt.Errorf("split.AddAggColumn: agg results named: %v not found", aggName)
+	}
+	col := dt.Cols[ag.ColIndex]
+	shp := append([]int{dt.Rows}, col.Shapes()[1:]...)
+	dnm := append([]string{"row"}, col.DimNames()[1:]...)
+	nc := etensor.NewFloat64(shp, nil, dnm)
+	for i := range nc.Values {
+		nc.Values[i] = math.NaN()
+	}
+	_, csz := nc.RowCellSize()
+	for si, sp := range spl.Splits {
+		av := ag.Aggs[si]
+		for _, row := range sp.Indexes {
+			copy(nc.Values[row*csz:(row+1)*csz], av)
+		}
+	}
+	return dt.AddColTry(nc, newColName) // calls Changed
+}
+
 ///////////////////////////////////////////////////
 //   Desc
 
@@ -107,3 +218,32 @@ func DescTry(spl *etable.Splits, colNm string) error {
 	DescIndex(spl, colIndex)
 	return nil
 }
+
+///////////////////////////////////////////////////
+//   Counts
+
+// CountsTable returns a Table with the number of rows in each split, e.g.,
+// the frequency table of each combination of levels from GroupBy, as needed
+// for a chi-square test.  As in Splits.AggsToTable, there is a String column
+// for each of the Levels, followed by an "N" column with the row counts.
+// Returns nil if there are no splits.
+func CountsTable(spl *etable.Splits) *etable.Table {
+	nsp := len(spl.Splits)
+	if nsp == 0 {
+		return nil
+	}
+	sc := etable.Schema{}
+	for _, cn := range spl.Levels {
+		sc = append(sc, etable.Column{cn, etensor.STRING, nil, nil})
+	}
+	sc = append(sc, etable.Column{"N", etensor.INT, nil, nil})
+	st := etable.New(sc, nsp)
+	nc := len(spl.Levels)
+	for si, sp := range spl.Splits {
+		for ci := range spl.Levels {
+			st.Cols[ci].SetString1D(si, spl.Values[si][ci])
+		}
+		st.Cols[nc].SetFloat1D(si, float64(len(sp.Indexes)))
+	}
+	return st
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024, The Goki Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package split
+
+import (
+	"testing"
+
+	"github.com/emer/etable/v2/agg"
+	"github.com/emer/etable/v2/etable"
+	"github.com/emer/etable/v2/etensor"
+)
+
+func TestCountsTable(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Resp", etensor.STRING, nil, nil},
+	}, 10)
+	conds := []string{"A", "A", "A", "B", "B", "A", "B", "B", "B", "A"}
+	resps := []string{"y", "n", "y", "y", "y", "y", "n", "n", "y", "n"}
+	exp := map[string]int{}
+	for i := range conds {
+		dt.SetCellString("Cond", i, conds[i])
+		dt.SetCellString("Resp", i, resps[i])
+		exp[conds[i]+resps[i]]++
+	}
+	spl := GroupBy(etable.NewIndexView(dt), []string{"Cond", "Resp"})
+	ct := CountsTable(spl)
+	if ct.Rows != 4 || ct.NumCols() != 3 || ct.ColNames[2] != "N" {
+		t.Fatalf("CountsTable: rows %d cols %v", ct.Rows, ct.ColNames)
+	}
+	tot := 0
+	for i := 0; i < ct.Rows; i++ {
+		n := int(ct.CellFloat("N", i))
+		tot += n
+		key := ct.CellString("Cond", i) + ct.CellString("Resp", i)
+		if n != exp[key] {
+			t.Errorf("CountsTable %s: %d != %d", key, n, exp[key])
+		}
+	}
+	if tot != dt.Rows {
+		t.Errorf("CountsTable total: %d != %d", tot, dt.Rows)
+	}
+}
+
+func TestAggName(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 5)
+	conds := []string{"A", "B", "A", "B", "A"}
+	for i, c := range conds {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	agg.Register("Range", func(ix *etable.IndexView, colNm string) []float64 {
+		mx := agg.Max(ix, colNm)
+		mn := agg.Min(ix, colNm)
+		if mx == nil || mn == nil {
+			return nil
+		}
+		return []float64{mx[0] - mn[0]}
+	})
+	spl := GroupBy(etable.NewIndexView(dt), []string{"Cond"})
+	if _, err := AggName(spl, "Val", "mean"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AggName(spl, "Val", "Range"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AggName(spl, "Val", "NoSuchAgg"); err == nil {
+		t.Error("AggName: expected error for unregistered name")
+	}
+	at := spl.AggsToTable(etable.AddAggName)
+	if at.CellFloat("Val:Mean", 0) != 2 || at.CellFloat("Val:Range", 0) != 4 || at.CellFloat("Val:Range", 1) != 2 {
+		t.Errorf("AggName: Mean %v Range A %v B %v", at.CellFloat("Val:Mean", 0), at.CellFloat("Val:Range", 0), at.CellFloat("Val:Range", 1))
+	}
+}
+
+func TestAddAggColumn(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"Val", etensor.FLOAT64, nil, nil},
+	}, 6)
+	conds := []string{"A", "B", "A", "B", "A", "B"}
+	for i, c := range conds {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("Val", i, float64(i))
+	}
+	spl := GroupBy(etable.NewIndexView(dt), []string{"Cond"})
+	Agg(spl, "Val", agg.AggMean)
+	nchg := 0
+	dt.OnChange(func() { nchg++ })
+	if err := AddAggColumn(spl, "Val:Mean", "GroupMean"); err != nil {
+		t.Fatal(err)
+	}
+	if nchg != 1 {
+		t.Errorf("AddAggColumn: OnChange called %d times != 1", nchg)
+	}
+	exp := map[string]float64{"A": 2, "B": 3}
+	for i, c := range conds {
+		if v := dt.CellFloat("GroupMean", i); v != exp[c] {
+			t.Errorf("AddAggColumn row %d (%s): %v != %v", i, c, v, exp[c])
+		}
+	}
+	if dt.CellFloat("GroupMean", 0) == dt.CellFloat("GroupMean", 1) {
+		t.Error("AddAggColumn: different groups should differ")
+	}
+	if err := AddAggColumn(spl, "Val:Mean", "GroupMean"); err == nil {
+		t.Error("AddAggColumn: expected error for existing column")
+	}
+	if err := AddAggColumn(spl, "Val:Std", "GroupStd"); err == nil {
+		t.Error("AddAggColumn: expected error for missing agg")
+	}
+}
+
+func TestAggCols(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Cond", etensor.STRING, nil, nil},
+		{"A", etensor.FLOAT64, nil, nil},
+		{"B", etensor.FLOAT64, nil, nil},
+	}, 4)
+	conds := []string{"X", "Y", "X", "Y"}
+	for i, c := range conds {
+		dt.SetCellString("Cond", i, c)
+		dt.SetCellFloat("A", i, float64(i))
+		dt.SetCellFloat("B", i, float64(10*i))
+	}
+	spl := GroupBy(etable.NewIndexView(dt), []string{"Cond"})
+	ags, err := AggCols(spl, []string{"A", "B"}, "Mean")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ags) != 2 || len(spl.Aggs) != 2 {
+		t.Fatalf("AggCols: %d aggs", len(ags))
+	}
+	at := spl.AggsToTable(etable.AddAggName)
+	if at.CellFloat("A:Mean", 0) != 1 || at.CellFloat("B:Mean", 1) != 20 {
+		t.Errorf("AggCols: A:Mean %v B:Mean %v", at.CellFloat("A:Mean", 0), at.CellFloat("B:Mean", 1))
+	}
+	if _, err := AggCols(spl, []string{"A", "Missing"}, "Mean"); err == nil || len(spl.Aggs) != 2 {
+		t.Error("AggCols: expected error without aggregating for missing column")
+	}
+}
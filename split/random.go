@@ -7,6 +7,8 @@ package split
 import (
 	"fmt"
 	"math"
+	"math/rand"
+	"strconv"
 
 	"github.com/emer/etable/v2/etable"
 	"gonum.org/v1/gonum/floats"
@@ -61,3 +63,34 @@ func Permuted(ix *etable.IndexView, probs []float64, names []string) (*etable.Sp
 	}
 	return spl, nil
 }
+
+// PermutedGroups generates nGroups random splits of table rows of
+// approximately equal size (differing by at most 1), e.g., for k-fold
+// cross-validation.  The given rand source is used for the permutation,
+// so results are reproducible for a given seed -- uses the global
+// math/rand source if nil.  The Levels are set to "fold" and the
+// Values are the group numbers, starting at 0.
+func PermutedGroups(ix *etable.IndexView, nGroups int, rnd *rand.Rand) (*etable.Splits, error) {
+	if ix == nil || ix.Len() == 0 {
+		return nil, fmt.Errorf("split.PermutedGroups table is nil / empty")
+	}
+	if nGroups <= 0 {
+		return nil, fmt.Errorf("split.PermutedGroups nGroups must be > 0, is: %d", nGroups)
+	}
+	nr := ix.Len()
+	perm := make([]int, nr)
+	copy(perm, ix.Indexes)
+	if rnd != nil {
+		rnd.Shuffle(nr, func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+	} else {
+		rand.Shuffle(nr, func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+	}
+	spl := &etable.Splits{}
+	spl.SetLevels("fold")
+	for i := 0; i < nGroups; i++ {
+		st := (i * nr) / nGroups
+		ed := ((i + 1) * nr) / nGroups
+		spl.New(ix.Table, []string{strconv.Itoa(i)}, perm[st:ed]...)
+	}
+	return spl, nil
+}
@@ -6,6 +6,8 @@ package split
 
 import (
 	"fmt"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/emer/etable/v2/etable"
@@ -35,3 +37,39 @@ func TestPermuted(t *testing.T) {
 		fmt.Printf("split: %v name: %v len: %v idxs: %v\n", i, spl.Values[i], len(sp.Indexes), sp.Indexes)
 	}
 }
+
+func TestPermutedGroups(t *testing.T) {
+	dt := etable.New(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+	}, 23)
+	ix := etable.NewIndexView(dt)
+	spl, err := PermutedGroups(ix, 5, rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spl.Splits) != 5 || spl.Levels[0] != "fold" {
+		t.Fatalf("PermutedGroups: splits: %d levels: %v", len(spl.Splits), spl.Levels)
+	}
+	seen := make(map[int]bool)
+	for i, sp := range spl.Splits {
+		if n := len(sp.Indexes); n < 4 || n > 5 {
+			t.Errorf("PermutedGroups: split %d len: %d not in [4,5]", i, n)
+		}
+		for _, ri := range sp.Indexes {
+			if seen[ri] {
+				t.Errorf("PermutedGroups: row %d in more than one split", ri)
+			}
+			seen[ri] = true
+		}
+	}
+	if len(seen) != dt.Rows {
+		t.Errorf("PermutedGroups: covered %d rows != %d", len(seen), dt.Rows)
+	}
+
+	spl2, _ := PermutedGroups(ix, 5, rand.New(rand.NewSource(42)))
+	for i, sp := range spl.Splits {
+		if !slices.Equal(sp.Indexes, spl2.Splits[i].Indexes) {
+			t.Errorf("PermutedGroups: split %d not reproducible with same seed", i)
+		}
+	}
+}